@@ -0,0 +1,12 @@
+data "humanitec_active_resources" "postgres" {
+  app_id = "example-app"
+  env_id = "development"
+
+  filter = {
+    def_id = "postgres"
+  }
+}
+
+output "postgres_definition_versions" {
+  value = { for res in data.humanitec_active_resources.postgres.resources : res.res_id => res.def_version_id }
+}
@@ -0,0 +1,5 @@
+data "humanitec_api_usage" "current" {}
+
+output "remaining_requests" {
+  value = data.humanitec_api_usage.current.rate_limit_remaining
+}
@@ -0,0 +1,8 @@
+data "humanitec_deployment_errors" "latest" {
+  app_id = "example-app"
+  env_id = "production"
+}
+
+output "deployment_error_summaries" {
+  value = [for e in data.humanitec_deployment_errors.latest.errors : "${e.object_id}: ${e.summary}"]
+}
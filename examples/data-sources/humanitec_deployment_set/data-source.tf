@@ -0,0 +1,11 @@
+data "humanitec_deployment_set" "production" {
+  app_id = "example-app"
+  env_id = "production"
+}
+
+check "production_image" {
+  assert {
+    condition     = endswith(jsondecode(data.humanitec_deployment_set.production.modules_json)["api"]["spec"]["containers"]["api"]["image"], ":v1.2.3")
+    error_message = "The api module in production is not running v1.2.3."
+  }
+}
@@ -0,0 +1,7 @@
+data "humanitec_driver_schema" "s3" {
+  driver_type = "humanitec/s3"
+}
+
+locals {
+  s3_required_values = jsondecode(data.humanitec_driver_schema.s3.inputs_schema).properties.values.required
+}
@@ -0,0 +1,5 @@
+data "humanitec_environment_types" "all" {}
+
+output "unused_environment_types" {
+  value = [for env_type in data.humanitec_environment_types.all.environment_types : env_type.id if env_type.environments_count == 0]
+}
@@ -0,0 +1,7 @@
+data "humanitec_expired_environments" "previews" {
+  app_id = "example-app"
+}
+
+output "expired_environment_ids" {
+  value = [for env in data.humanitec_expired_environments.previews.environments : env.id]
+}
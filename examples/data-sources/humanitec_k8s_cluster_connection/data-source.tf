@@ -0,0 +1,7 @@
+data "humanitec_k8s_cluster_connection" "gke" {
+  definition_id = "gke-dev"
+}
+
+output "loadbalancer" {
+  value = data.humanitec_k8s_cluster_connection.gke.loadbalancer
+}
@@ -0,0 +1,7 @@
+data "humanitec_pipeline_criteria" "example" {
+  app_id = "example-app"
+}
+
+output "development_criteria" {
+  value = [for c in data.humanitec_pipeline_criteria.example.criteria : c.pipeline_id if c.env_id == "development"]
+}
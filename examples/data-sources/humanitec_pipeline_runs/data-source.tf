@@ -0,0 +1,13 @@
+data "humanitec_pipeline_runs" "failed" {
+  app_id         = "example-app"
+  pipeline_id    = "release"
+  statuses       = ["failed"]
+  created_within = "24h"
+}
+
+check "no_failed_releases" {
+  assert {
+    condition     = length(data.humanitec_pipeline_runs.failed.runs) == 0
+    error_message = "The release pipeline failed in the last 24 hours."
+  }
+}
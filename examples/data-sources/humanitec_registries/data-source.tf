@@ -0,0 +1,5 @@
+data "humanitec_registries" "main" {}
+
+output "ci_registry_ids" {
+  value = [for r in data.humanitec_registries.main.registries : r.id if r.enable_ci]
+}
@@ -0,0 +1,14 @@
+data "humanitec_resource_definition_criteria" "prod_db" {
+  type     = "postgres"
+  app_id   = "example-app"
+  env_id   = "production"
+  env_type = "production"
+  res_id   = "shared.db"
+}
+
+check "prod_db_definition" {
+  assert {
+    condition     = data.humanitec_resource_definition_criteria.prod_db.resource_definition_id == "postgres-production"
+    error_message = "The production database isn't matched by the postgres-production resource definition."
+  }
+}
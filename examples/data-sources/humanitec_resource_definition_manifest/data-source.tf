@@ -0,0 +1,9 @@
+data "humanitec_resource_definition_manifest" "postgres" {
+  id = "postgres"
+}
+
+# Write the definition to a file developers can run `humctl diff -f` against
+resource "local_file" "postgres" {
+  filename = "${path.module}/definitions/postgres.yaml"
+  content  = data.humanitec_resource_definition_manifest.postgres.yaml
+}
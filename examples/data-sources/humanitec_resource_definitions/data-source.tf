@@ -0,0 +1,11 @@
+data "humanitec_resource_definitions" "postgres" {
+  filter = {
+    type   = "postgres"
+    app_id = "example-app"
+    env_id = "production"
+  }
+}
+
+output "postgres_definition_ids" {
+  value = [for d in data.humanitec_resource_definitions.postgres.definitions : d.id]
+}
@@ -0,0 +1,8 @@
+data "humanitec_resource_graph" "production" {
+  app_id = "example-app"
+  env_id = "production"
+}
+
+output "resource_graph" {
+  value = jsondecode(data.humanitec_resource_graph.production.graph_json)
+}
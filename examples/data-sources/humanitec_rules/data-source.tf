@@ -0,0 +1,8 @@
+data "humanitec_rules" "development" {
+  app_id = "example-app"
+  env_id = "development"
+}
+
+output "rules_created_before_2024" {
+  value = [for rule in data.humanitec_rules.development.rules : rule.id if timecmp(rule.created_at, "2024-01-01T00:00:00Z") < 0]
+}
@@ -0,0 +1,5 @@
+data "humanitec_secretstores" "main" {}
+
+locals {
+  primary_secretstore_id = one([for s in data.humanitec_secretstores.main.secretstores : s.id if s.primary])
+}
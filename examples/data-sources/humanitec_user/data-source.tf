@@ -0,0 +1,7 @@
+data "humanitec_user" "developer" {
+  email = "developer@example.com"
+}
+
+data "humanitec_user" "ci" {
+  name = "ci-service-user"
+}
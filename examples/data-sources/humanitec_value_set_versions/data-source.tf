@@ -0,0 +1,5 @@
+data "humanitec_value_set_versions" "example" {
+  app_id      = "example-app"
+  env_id      = "development"
+  key_changed = "EXAMPLE_KEY"
+}
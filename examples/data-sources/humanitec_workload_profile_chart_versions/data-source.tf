@@ -0,0 +1,15 @@
+data "humanitec_workload_profile_chart_versions" "custom" {
+  chart_id = "custom-chart"
+}
+
+# Pin the Workload Profile to the most recently uploaded chart version
+resource "humanitec_workload_profile" "custom" {
+  id              = "custom-profile"
+  description     = "Custom workload profile"
+  spec_definition = jsonencode({})
+
+  workload_profile_chart = {
+    id      = data.humanitec_workload_profile_chart_versions.custom.chart_id
+    version = data.humanitec_workload_profile_chart_versions.custom.chart_versions[0].version
+  }
+}
@@ -1 +1,4 @@
 terraform import humanitec_environment.example application_id/environment_id
+
+# import using the URL of the Humanitec console
+terraform import humanitec_environment.example https://app.humanitec.io/orgs/my-org/apps/application_id/envs/environment_id
@@ -4,3 +4,11 @@ resource "humanitec_environment" "example" {
   name   = "An example environment"
   type   = "development"
 }
+
+resource "humanitec_environment" "clone" {
+  app_id      = "example-app"
+  id          = "clone"
+  name        = "A clone of the example environment"
+  type        = "development"
+  from_env_id = humanitec_environment.example.id
+}
@@ -1 +1,4 @@
 terraform import humanitec_pipeline.example app_id/pipeline_id
+
+# import using the URL of the Humanitec console
+terraform import humanitec_pipeline.example https://app.humanitec.io/orgs/my-org/apps/app_id/pipelines/pipeline_id
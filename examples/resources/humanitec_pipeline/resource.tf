@@ -13,3 +13,14 @@ jobs:
         message: Hello from Terraform
 EOT
 }
+
+# The definition can be split into files, pipelines/release.yaml can contain e.g.
+#
+#   jobs:
+#     deploy:
+#       steps:
+#       {{ include "steps/deploy.yaml" }}
+resource "humanitec_pipeline" "release" {
+  app_id          = "example-app"
+  definition_file = "${path.module}/pipelines/release.yaml"
+}
@@ -11,6 +11,19 @@ resource "humanitec_resource_definition" "s3" {
   }
 }
 
+resource "humanitec_resource_definition" "dns" {
+  id   = "dns-dev"
+  name = "dns-dev"
+  type = "dns"
+
+  driver_type = "humanitec/static"
+  driver_inputs = {
+    values = {
+      host = "dev.example.com"
+    }
+  }
+}
+
 resource "humanitec_resource_definition" "postgres" {
   id          = "db-dev"
   name        = "db-dev"
@@ -91,4 +104,41 @@ resource "humanitec_resource_definition" "azure-blob" {
       }
     })
   }
-}
\ No newline at end of file
+}
+
+resource "humanitec_resource_definition" "config_map" {
+  id          = "app-config-map"
+  name        = "app-config-map"
+  type        = "config"
+  driver_type = "humanitec/template"
+
+  driver_inputs = {
+    manifests = [
+      {
+        path    = "config-map.yaml"
+        content = <<EOT
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: app-config
+data:
+  app-id: "{{ .context.app.id }}"
+EOT
+      }
+    ]
+  }
+}
+
+resource "humanitec_resource_definition" "dns_yaml" {
+  id          = "dns-yaml"
+  name        = "dns-yaml"
+  type        = "dns"
+  driver_type = "humanitec/dns-wildcard"
+
+  driver_inputs = {
+    values_yaml = <<EOT
+domain: my-domain.com
+template: "{{ .context.app.id }}-{{ .context.env.id }}"
+EOT
+  }
+}
@@ -21,3 +21,11 @@ resource "humanitec_resource_definition_criteria" "example" {
   resource_definition_id = humanitec_resource_definition.example.id
   app_id                 = "example-app"
 }
+
+# The API matches a single app_id per criteria, use for_each to match multiple applications
+resource "humanitec_resource_definition_criteria" "apps" {
+  for_each = toset(["example-app-a", "example-app-b"])
+
+  resource_definition_id = humanitec_resource_definition.example.id
+  app_id                 = each.value
+}
@@ -0,0 +1,8 @@
+resource "humanitec_resource_graph_action" "rotate_db_credentials" {
+  definition_id = humanitec_resource_definition.postgres.id
+  comment       = "Roll out rotated database credentials"
+
+  triggers = {
+    credentials_version = aws_secretsmanager_secret_version.postgres.version_id
+  }
+}
@@ -1,2 +1,5 @@
 # import an existing rule
 terraform import humanitec_rule.my_rule app_id/env_id/rule_id
+
+# import an existing rule with an id containing slashes
+terraform import humanitec_rule.my_rule app_id::env_id::rule/id
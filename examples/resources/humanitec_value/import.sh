@@ -3,3 +3,9 @@ terraform import humanitec_value.val1 app_id/key
 
 # import an existing app env value
 terraform import humanitec_value.val1 app_id/env_id/key
+
+# import an existing app env value with a key containing slashes
+terraform import humanitec_value.val1 app_id::env_id::path/to/key
+
+# import using the URL of the Humanitec console
+terraform import humanitec_value.val1 https://app.humanitec.io/orgs/my-org/apps/app_id/envs/env_id/values/key
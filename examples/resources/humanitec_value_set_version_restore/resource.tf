@@ -0,0 +1,11 @@
+data "humanitec_value_set_versions" "example" {
+  app_id = "example-app"
+  env_id = "development"
+}
+
+resource "humanitec_value_set_version_restore" "rollback" {
+  app_id               = "example-app"
+  env_id               = "development"
+  value_set_version_id = one([for v in data.humanitec_value_set_versions.example.value_set_versions : v.id if v.comment == "Release 1.2.0"])
+  comment              = "Rollback to release 1.2.0"
+}
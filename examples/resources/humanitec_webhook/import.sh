@@ -1,2 +1,8 @@
 # import an existing webhook
 terraform import humanitec_webhook.my_hook app_id/webhook_id
+
+# import an existing webhook with an id containing slashes
+terraform import humanitec_webhook.my_hook app_id::webhook/id
+
+# import using the URL of the Humanitec console
+terraform import humanitec_webhook.my_hook https://app.humanitec.io/orgs/my-org/apps/app_id/webhooks/webhook_id
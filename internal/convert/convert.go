@@ -0,0 +1,67 @@
+// Package convert contains helpers converting between the optional values of the API client and Terraform values.
+//
+// A missing API value is mapped to null unless the attribute defaults to an empty value, in that case the
+// empty value is used to avoid diffs between the configuration and the state.
+package convert
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ToPtr returns a pointer to a copy of the value.
+func ToPtr[T any](value T) *T {
+	return &value
+}
+
+// FindInSlicePtr returns the first element of the slice for which f returns true, the slice may be nil.
+func FindInSlicePtr[T any](in *[]T, f func(T) bool) (T, bool) {
+	var element T
+
+	if in == nil {
+		return element, false
+	}
+
+	for _, e := range *in {
+		if f(e) {
+			return e, true
+		}
+	}
+
+	return element, false
+}
+
+// OptionalString returns null for a missing string.
+func OptionalString(input *string) types.String {
+	if input == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(*input)
+}
+
+// NonEmptyString returns null for a missing or empty string, for attributes where the API returns an empty string once unset.
+func NonEmptyString(input *string) types.String {
+	if input == nil || *input == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(*input)
+}
+
+// StringOrEmpty returns an empty string for a missing string, for attributes defaulting to an empty string.
+func StringOrEmpty(input *string) types.String {
+	if input == nil {
+		return types.StringValue("")
+	}
+
+	return types.StringValue(*input)
+}
+
+// BoolOrFalse returns false for a missing bool, for attributes defaulting to false.
+func BoolOrFalse(input *bool) types.Bool {
+	if input == nil {
+		return types.BoolValue(false)
+	}
+
+	return types.BoolValue(*input)
+}
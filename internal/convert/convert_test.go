@@ -0,0 +1,92 @@
+package convert
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToPtr(t *testing.T) {
+	assert := assert.New(t)
+
+	value := "test"
+	ptr := ToPtr(value)
+	assert.Equal("test", *ptr)
+
+	// The pointer references a copy
+	*ptr = "changed"
+	assert.Equal("test", value)
+}
+
+func TestFindInSlicePtr(t *testing.T) {
+	assert := assert.New(t)
+
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	found, ok := FindInSlicePtr(&[]int{1, 2, 3, 4}, isEven)
+	assert.True(ok)
+	assert.Equal(2, found)
+
+	found, ok = FindInSlicePtr(&[]int{1, 3}, isEven)
+	assert.False(ok)
+	assert.Equal(0, found)
+
+	found, ok = FindInSlicePtr(&[]int{}, isEven)
+	assert.False(ok)
+	assert.Equal(0, found)
+
+	found, ok = FindInSlicePtr[int](nil, isEven)
+	assert.False(ok)
+	assert.Equal(0, found)
+}
+
+func TestStrings(t *testing.T) {
+	testCases := []struct {
+		name           string
+		input          *string
+		optionalString types.String
+		nonEmptyString types.String
+		stringOrEmpty  types.String
+	}{
+		{
+			name:           "missing",
+			input:          nil,
+			optionalString: types.StringNull(),
+			nonEmptyString: types.StringNull(),
+			stringOrEmpty:  types.StringValue(""),
+		},
+		{
+			name:           "empty",
+			input:          ToPtr(""),
+			optionalString: types.StringValue(""),
+			nonEmptyString: types.StringNull(),
+			stringOrEmpty:  types.StringValue(""),
+		},
+		{
+			name:           "set",
+			input:          ToPtr("value"),
+			optionalString: types.StringValue("value"),
+			nonEmptyString: types.StringValue("value"),
+			stringOrEmpty:  types.StringValue("value"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(tc.optionalString, OptionalString(tc.input))
+			assert.Equal(tc.nonEmptyString, NonEmptyString(tc.input))
+			assert.Equal(tc.stringOrEmpty, StringOrEmpty(tc.input))
+		})
+	}
+}
+
+func TestBoolOrFalse(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(types.BoolValue(false), BoolOrFalse(nil))
+	assert.Equal(types.BoolValue(false), BoolOrFalse(ToPtr(false)))
+	assert.Equal(types.BoolValue(true), BoolOrFalse(ToPtr(true)))
+}
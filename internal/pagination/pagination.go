@@ -0,0 +1,74 @@
+// Package pagination iterates over the pages of list endpoints of the Humanitec API.
+package pagination
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+)
+
+var nextLinkRegexp = regexp.MustCompile(`<([^>]+)>\s*;\s*rel="?next"?`)
+
+// RequestEditor selects the page to fetch, it has to be passed as request editor to the client call.
+type RequestEditor = func(ctx context.Context, req *http.Request) error
+
+// Page fetches a single page of a list, returning its items and the raw http response.
+type Page[T any] func(ctx context.Context, editor RequestEditor) ([]T, *http.Response, error)
+
+// NextLink returns the link to the next page advertised in the Link header or an empty string on the last page.
+func NextLink(header http.Header) string {
+	for _, link := range header.Values("Link") {
+		if match := nextLinkRegexp.FindStringSubmatch(link); match != nil {
+			return match[1]
+		}
+	}
+	return ""
+}
+
+// WithQueryOf returns a request editor copying the query parameters of the link onto the request.
+func WithQueryOf(link string) (RequestEditor, error) {
+	next, err := url.Parse(link)
+	if err != nil {
+		return nil, fmt.Errorf("invalid next page link %q: %w", link, err)
+	}
+
+	return func(ctx context.Context, req *http.Request) error {
+		query := req.URL.Query()
+		for key, values := range next.Query() {
+			query[key] = values
+		}
+		req.URL.RawQuery = query.Encode()
+		return nil
+	}, nil
+}
+
+// All fetches every page of a list and returns their concatenated items.
+func All[T any](ctx context.Context, page Page[T]) ([]T, error) {
+	items := []T{}
+	editor := func(ctx context.Context, req *http.Request) error { return nil }
+	seen := map[string]bool{}
+
+	for {
+		pageItems, httpResp, err := page(ctx, editor)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, pageItems...)
+
+		if httpResp == nil {
+			return items, nil
+		}
+		link := NextLink(httpResp.Header)
+		if link == "" || seen[link] {
+			return items, nil
+		}
+		seen[link] = true
+
+		editor, err = WithQueryOf(link)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package pagination
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextLink(t *testing.T) {
+	assert := assert.New(t)
+
+	header := http.Header{}
+	assert.Equal("", NextLink(header))
+
+	header.Set("Link", `<https://api.humanitec.io/orgs/test/agents?page=abc&per_page=2>; rel="next"`)
+	assert.Equal("https://api.humanitec.io/orgs/test/agents?page=abc&per_page=2", NextLink(header))
+
+	header.Set("Link", `<https://api.humanitec.io/orgs/test/agents?page=abc>; rel="prev", <https://api.humanitec.io/orgs/test/agents?page=def>; rel="next"`)
+	assert.Equal("https://api.humanitec.io/orgs/test/agents?page=def", NextLink(header))
+}
+
+func TestAll(t *testing.T) {
+	assert := assert.New(t)
+
+	pages := map[string][]string{
+		"":  {"a", "b"},
+		"2": {"c", "d"},
+		"3": {"e"},
+	}
+	next := map[string]string{
+		"":  "2",
+		"2": "3",
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		assert.Equal("value", r.URL.Query().Get("filter"))
+		if n, ok := next[page]; ok {
+			w.Header().Set("Link", `<`+r.URL.Path+`?page=`+n+`>; rel="next"`)
+		}
+		assert.NoError(json.NewEncoder(w).Encode(pages[page]))
+	}))
+	defer srv.Close()
+
+	items, err := All(context.Background(), func(ctx context.Context, editor RequestEditor) ([]string, *http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/items?filter=value", nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := editor(ctx, req); err != nil {
+			return nil, nil, err
+		}
+		httpResp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer httpResp.Body.Close()
+
+		var items []string
+		if err := json.NewDecoder(httpResp.Body).Decode(&items); err != nil {
+			return nil, nil, err
+		}
+		return items, httpResp, nil
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"a", "b", "c", "d", "e"}, items)
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/hashcode"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ActiveResourcesDataSource{}
+
+func NewActiveResourcesDataSource() datasource.DataSource {
+	return &ActiveResourcesDataSource{}
+}
+
+// ActiveResourcesDataSource defines the data source implementation.
+type ActiveResourcesDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// ActiveResourcesDataSourceModel describes the data source data model.
+type ActiveResourcesDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	AppID     types.String `tfsdk:"app_id"`
+	EnvID     types.String `tfsdk:"env_id"`
+	Filter    types.Object `tfsdk:"filter"`
+	Resources types.List   `tfsdk:"resources"`
+}
+
+type ActiveResourcesFilterDataSourceModel struct {
+	Type  types.String `tfsdk:"type"`
+	Class types.String `tfsdk:"class"`
+	ResID types.String `tfsdk:"res_id"`
+	DefID types.String `tfsdk:"def_id"`
+}
+
+// ActiveResourceDataSourceModel describes a single active resource.
+type ActiveResourceDataSourceModel struct {
+	GuResID      types.String `tfsdk:"gu_res_id"`
+	Type         types.String `tfsdk:"type"`
+	Class        types.String `tfsdk:"class"`
+	ResID        types.String `tfsdk:"res_id"`
+	DefID        types.String `tfsdk:"def_id"`
+	DefVersionID types.String `tfsdk:"def_version_id"`
+	DriverType   types.String `tfsdk:"driver_type"`
+	Status       types.String `tfsdk:"status"`
+}
+
+var activeResourceAttrTypes = map[string]attr.Type{
+	"gu_res_id":      types.StringType,
+	"type":           types.StringType,
+	"class":          types.StringType,
+	"res_id":         types.StringType,
+	"def_id":         types.StringType,
+	"def_version_id": types.StringType,
+	"driver_type":    types.StringType,
+	"status":         types.StringType,
+}
+
+func (d *ActiveResourcesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_active_resources"
+}
+
+func (d *ActiveResourcesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Active Resources of an environment, e.g. to verify all of them have been provisioned with the latest version of a Resource Definition.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The Application ID.",
+				Required:            true,
+			},
+			"env_id": schema.StringAttribute{
+				MarkdownDescription: "The Environment ID.",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The Resource Type, e.g. `postgres`.",
+						Optional:            true,
+					},
+					"class": schema.StringAttribute{
+						MarkdownDescription: "The Resource Class.",
+						Optional:            true,
+					},
+					"res_id": schema.StringAttribute{
+						MarkdownDescription: "The Resource ID, e.g. `modules.my-module.externals.my-db` or `shared.my-db`.",
+						Optional:            true,
+					},
+					"def_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the Resource Definition the resources were provisioned from.",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"resources": schema.ListAttribute{
+				MarkdownDescription: "List of active resources with their `gu_res_id`, `type`, `class`, `res_id`, `def_id`, `def_version_id`, `driver_type` and `status`.",
+				ElementType: types.ObjectType{
+					AttrTypes: activeResourceAttrTypes,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *ActiveResourcesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *ActiveResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ActiveResourcesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter ActiveResourcesFilterDataSourceModel
+	if !data.Filter.IsNull() {
+		resp.Diagnostics.Append(data.Filter.As(ctx, &filter, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	appID := data.AppID.ValueString()
+	envID := data.EnvID.ValueString()
+
+	httpResp, err := d.client.ListActiveResourcesWithResponse(ctx, d.orgId, appID, envID)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list active resources, got error: %s", err))
+		return
+	}
+	if httpResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list active resources, unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	guResIds := []string{appID, envID}
+	resources := []basetypes.ObjectValue{}
+	if httpResp.JSON200 != nil {
+		for _, res := range *httpResp.JSON200 {
+			if !activeResourceMatches(res, filter) {
+				continue
+			}
+
+			item, diags := types.ObjectValueFrom(ctx, activeResourceAttrTypes, &ActiveResourceDataSourceModel{
+				GuResID:      types.StringValue(res.GuResId),
+				Type:         types.StringValue(res.Type),
+				Class:        types.StringValue(res.Class),
+				ResID:        types.StringValue(res.ResId),
+				DefID:        types.StringValue(res.DefId),
+				DefVersionID: types.StringValue(res.DefVersionId),
+				DriverType:   types.StringValue(res.DriverType),
+				Status:       types.StringValue(res.Status),
+			})
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			guResIds = append(guResIds, res.GuResId)
+			resources = append(resources, item)
+		}
+	}
+
+	resourcesList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: activeResourceAttrTypes}, resources)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Resources = resourcesList
+	data.ID = types.StringValue(hashcode.Strings(guResIds))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// activeResourceMatches reports whether the active resource matches all attributes set in the filter.
+func activeResourceMatches(res client.ActiveResourceResponse, filter ActiveResourcesFilterDataSourceModel) bool {
+	resType := filter.Type.ValueStringPointer()
+	class := filter.Class.ValueStringPointer()
+	resID := filter.ResID.ValueStringPointer()
+	defID := filter.DefID.ValueStringPointer()
+
+	matchesTypeIfSet := resType == nil || res.Type == *resType
+	matchesClassIfSet := class == nil || res.Class == *class
+	matchesResIDIfSet := resID == nil || res.ResId == *resID
+	matchesDefIDIfSet := defID == nil || res.DefId == *defID
+
+	return matchesTypeIfSet && matchesClassIfSet && matchesResIDIfSet && matchesDefIDIfSet
+}
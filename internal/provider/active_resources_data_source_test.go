@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/humanitec/humanitec-go-autogen/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccActiveResourcesDataSource(t *testing.T) {
+	appID := fmt.Sprintf("active-res-test-%d", time.Now().UnixNano())
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActiveResourcesDataSourceConfig(appID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.humanitec_active_resources.test", "resources.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccActiveResourcesDataSourceConfig(appID string) string {
+	return fmt.Sprintf(`
+resource "humanitec_application" "test" {
+	id   = "%s"
+	name = "active-res-test"
+}
+
+data "humanitec_active_resources" "test" {
+	app_id = humanitec_application.test.id
+	env_id = "development"
+
+	filter = {
+		def_id = "missing-definition"
+	}
+}
+`, appID)
+}
+
+func TestActiveResourceMatches(t *testing.T) {
+	res := client.ActiveResourceResponse{
+		Type:  "postgres",
+		Class: "default",
+		ResId: "shared.db",
+		DefId: "postgres-def",
+	}
+
+	tests := []struct {
+		name     string
+		filter   ActiveResourcesFilterDataSourceModel
+		expected bool
+	}{
+		{
+			name:     "empty filter",
+			filter:   ActiveResourcesFilterDataSourceModel{},
+			expected: true,
+		},
+		{
+			name: "all matching",
+			filter: ActiveResourcesFilterDataSourceModel{
+				Type:  types.StringValue("postgres"),
+				Class: types.StringValue("default"),
+				ResID: types.StringValue("shared.db"),
+				DefID: types.StringValue("postgres-def"),
+			},
+			expected: true,
+		},
+		{
+			name:     "other class",
+			filter:   ActiveResourcesFilterDataSourceModel{Class: types.StringValue("ha")},
+			expected: false,
+		},
+		{
+			name:     "other res_id",
+			filter:   ActiveResourcesFilterDataSourceModel{ResID: types.StringValue("shared.cache")},
+			expected: false,
+		},
+		{
+			name:     "other def_id",
+			filter:   ActiveResourcesFilterDataSourceModel{DefID: types.StringValue("other-def")},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, activeResourceMatches(res, tc.filter))
+		})
+	}
+}
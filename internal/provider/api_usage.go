@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/humanitec/humanitec-go-autogen/client"
+)
+
+// apiUsageRecorder wraps the HTTP client of the provider and records the API usage reported in the rate limit response headers.
+type apiUsageRecorder struct {
+	doer client.HttpRequestDoer
+
+	mu        sync.Mutex
+	requests  int64
+	limit     *int64
+	remaining *int64
+	reset     *int64
+}
+
+// apiUsage is a snapshot of the recorded API usage.
+type apiUsage struct {
+	Requests  int64
+	Limit     *int64
+	Remaining *int64
+	Reset     *int64
+}
+
+func newAPIUsageRecorder(doer client.HttpRequestDoer) *apiUsageRecorder {
+	return &apiUsageRecorder{doer: doer}
+}
+
+func (r *apiUsageRecorder) Do(req *http.Request) (*http.Response, error) {
+	res, err := r.doer.Do(req)
+
+	if metrics := operationMetricsFromContext(req.Context()); metrics != nil {
+		metrics.calls.Add(1)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests++
+	if res != nil {
+		r.limit = rateLimitHeader(res.Header, "X-RateLimit-Limit", r.limit)
+		r.remaining = rateLimitHeader(res.Header, "X-RateLimit-Remaining", r.remaining)
+		r.reset = rateLimitHeader(res.Header, "X-RateLimit-Reset", r.reset)
+	}
+
+	return res, err
+}
+
+func (r *apiUsageRecorder) snapshot() apiUsage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return apiUsage{
+		Requests:  r.requests,
+		Limit:     r.limit,
+		Remaining: r.remaining,
+		Reset:     r.reset,
+	}
+}
+
+// rateLimitHeader parses the integer header value, keeping the previous value if the header is missing or invalid.
+func rateLimitHeader(header http.Header, key string, previous *int64) *int64 {
+	v, err := strconv.ParseInt(header.Get(key), 10, 64)
+	if err != nil {
+		return previous
+	}
+	return &v
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/humanitec/humanitec-go-autogen"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &APIUsageDataSource{}
+
+func NewAPIUsageDataSource() datasource.DataSource {
+	return &APIUsageDataSource{}
+}
+
+// APIUsageDataSource defines the data source implementation.
+type APIUsageDataSource struct {
+	client *humanitec.Client
+	orgId  string
+	usage  *apiUsageRecorder
+}
+
+// APIUsageDataSourceModel describes the data source data model.
+type APIUsageDataSourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	RequestCount       types.Int64  `tfsdk:"request_count"`
+	RateLimitLimit     types.Int64  `tfsdk:"rate_limit_limit"`
+	RateLimitRemaining types.Int64  `tfsdk:"rate_limit_remaining"`
+	RateLimitReset     types.Int64  `tfsdk:"rate_limit_reset"`
+}
+
+func (d *APIUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_usage"
+}
+
+func (d *APIUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "API usage of the provider and the remaining rate limit quota as reported by the Humanitec API response headers. The rate limit attributes are null if the API doesn't report them.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"request_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of API requests sent by the provider during the current Terraform operation.",
+				Computed:            true,
+			},
+			"rate_limit_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests allowed in the current rate limit window.",
+				Computed:            true,
+			},
+			"rate_limit_remaining": schema.Int64Attribute{
+				MarkdownDescription: "Number of requests remaining in the current rate limit window.",
+				Computed:            true,
+			},
+			"rate_limit_reset": schema.Int64Attribute{
+				MarkdownDescription: "Time at which the current rate limit window resets, as reported by the API.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *APIUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+	d.usage = resdata.APIUsage
+}
+
+func (d *APIUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data APIUsageDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.usage == nil {
+		resp.Diagnostics.AddError(HUM_PROVIDER_ERR, "API usage is not recorded by the provider")
+		return
+	}
+
+	// Send a lightweight request so the rate limit values are up to date
+	httpResp, err := d.client.GetOrganizationWithResponse(ctx, d.orgId)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to read organization, got error: %s", err))
+		return
+	}
+	if httpResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to read organization, unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	usage := d.usage.snapshot()
+
+	data.ID = types.StringValue(d.orgId)
+	data.RequestCount = types.Int64Value(usage.Requests)
+	data.RateLimitLimit = types.Int64PointerValue(usage.Limit)
+	data.RateLimitRemaining = types.Int64PointerValue(usage.Remaining)
+	data.RateLimitReset = types.Int64PointerValue(usage.Reset)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAPIUsageDataSource(t *testing.T) {
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIUsageDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.humanitec_api_usage.test", "id"),
+					resource.TestCheckResourceAttrSet("data.humanitec_api_usage.test", "request_count"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAPIUsageDataSourceConfig = `
+data "humanitec_api_usage" "test" {}
+`
+
+func TestAPIUsageRecorder(t *testing.T) {
+	assert := assert.New(t)
+
+	remaining := "99"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		if remaining != "" {
+			w.Header().Set("X-RateLimit-Remaining", remaining)
+		}
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+	}))
+	defer srv.Close()
+
+	recorder := newAPIUsageRecorder(&http.Client{})
+
+	usage := recorder.snapshot()
+	assert.Equal(int64(0), usage.Requests)
+	assert.Nil(usage.Limit)
+	assert.Nil(usage.Remaining)
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	assert.NoError(err)
+	_, err = recorder.Do(req)
+	assert.NoError(err)
+
+	usage = recorder.snapshot()
+	assert.Equal(int64(1), usage.Requests)
+	assert.Equal(int64(100), *usage.Limit)
+	assert.Equal(int64(99), *usage.Remaining)
+	assert.Equal(int64(1700000000), *usage.Reset)
+
+	// Missing headers keep the last known value
+	remaining = ""
+	_, err = recorder.Do(req)
+	assert.NoError(err)
+
+	usage = recorder.snapshot()
+	assert.Equal(int64(2), usage.Requests)
+	assert.Equal(int64(99), *usage.Remaining)
+}
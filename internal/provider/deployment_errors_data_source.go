@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DeploymentErrorsDataSource{}
+
+func NewDeploymentErrorsDataSource() datasource.DataSource {
+	return &DeploymentErrorsDataSource{}
+}
+
+// DeploymentErrorsDataSource defines the data source implementation.
+type DeploymentErrorsDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// DeploymentErrorsDataSourceModel describes the data source data model.
+type DeploymentErrorsDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	AppID        types.String `tfsdk:"app_id"`
+	EnvID        types.String `tfsdk:"env_id"`
+	DeploymentID types.String `tfsdk:"deployment_id"`
+	Errors       types.List   `tfsdk:"errors"`
+}
+
+// DeploymentErrorModel describes a single deployment error.
+type DeploymentErrorModel struct {
+	Scope    types.String `tfsdk:"scope"`
+	ObjectID types.String `tfsdk:"object_id"`
+	Code     types.String `tfsdk:"code"`
+	Summary  types.String `tfsdk:"summary"`
+	Message  types.String `tfsdk:"message"`
+}
+
+var deploymentErrorAttrTypes = map[string]attr.Type{
+	"scope":     types.StringType,
+	"object_id": types.StringType,
+	"code":      types.StringType,
+	"summary":   types.StringType,
+	"message":   types.StringType,
+}
+
+func (d *DeploymentErrorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_errors"
+}
+
+func (d *DeploymentErrorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Errors of a Deployment of an Environment. Can be used by incident automation to pull the structured errors of a failed deployment.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the Application.",
+				Required:            true,
+			},
+			"env_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the Environment.",
+				Required:            true,
+			},
+			"deployment_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the Deployment, defaults to the latest Deployment of the Environment.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"errors": schema.ListAttribute{
+				MarkdownDescription: "List of Deployment errors with their `scope` (e.g. `workload`), the `object_id` of the affected object, the error `code`, a short `summary` and the full `message`.",
+				ElementType: types.ObjectType{
+					AttrTypes: deploymentErrorAttrTypes,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *DeploymentErrorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *DeploymentErrorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeploymentErrorsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := data.AppID.ValueString()
+	envID := data.EnvID.ValueString()
+	deploymentID := data.DeploymentID.ValueString()
+
+	if deploymentID == "" {
+		listDeploymentsResp, err := d.client.ListDeploymentsWithResponse(ctx, d.orgId, appID, envID, &client.ListDeploymentsParams{})
+		if err != nil {
+			resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list deployments of environment %s, got error: %s", envID, err))
+			return
+		}
+		if listDeploymentsResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list deployments of environment %s, unexpected status code: %d, body: %s", envID, listDeploymentsResp.StatusCode(), listDeploymentsResp.Body))
+			return
+		}
+
+		deployment, ok := latestDeployment(listDeploymentsResp.JSON200)
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("env_id"), HUM_INPUT_ERR, fmt.Sprintf("Environment %s has no deployment", envID))
+			return
+		}
+		deploymentID = deployment.Id
+	}
+
+	listErrorsResp, err := d.client.ListDeploymentErrorsWithResponse(ctx, d.orgId, appID, envID, deploymentID)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list errors of deployment %s, got error: %s", deploymentID, err))
+		return
+	}
+	if listErrorsResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list errors of deployment %s, unexpected status code: %d, body: %s", deploymentID, listErrorsResp.StatusCode(), listErrorsResp.Body))
+		return
+	}
+
+	deploymentErrors := []basetypes.ObjectValue{}
+	if listErrorsResp.JSON200 != nil {
+		for _, res := range *listErrorsResp.JSON200 {
+			deploymentError, diags := types.ObjectValueFrom(ctx, deploymentErrorAttrTypes, parseDeploymentErrorResponse(res))
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			deploymentErrors = append(deploymentErrors, deploymentError)
+		}
+	}
+
+	errorList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: deploymentErrorAttrTypes}, deploymentErrors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", appID, envID, deploymentID))
+	data.DeploymentID = types.StringValue(deploymentID)
+	data.Errors = errorList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// latestDeployment returns the most recently created deployment, regardless of its status.
+func latestDeployment(deployments *[]client.DeploymentResponse) (client.DeploymentResponse, bool) {
+	var latest client.DeploymentResponse
+	found := false
+
+	if deployments == nil {
+		return latest, found
+	}
+
+	for _, deployment := range *deployments {
+		if !found || deployment.CreatedAt.After(latest.CreatedAt) {
+			latest = deployment
+			found = true
+		}
+	}
+
+	return latest, found
+}
+
+func parseDeploymentErrorResponse(res client.DeploymentErrorResponse) *DeploymentErrorModel {
+	return &DeploymentErrorModel{
+		Scope:    types.StringValue(res.Scope),
+		ObjectID: types.StringValue(res.ObjectId),
+		Code:     types.StringValue(res.Code),
+		Summary:  types.StringValue(res.Summary),
+		Message:  types.StringValue(res.Message),
+	}
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/humanitec/humanitec-go-autogen/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDeploymentErrorsDataSource_NoDeployment(t *testing.T) {
+	appID := fmt.Sprintf("tf-deployment-errors-%d", time.Now().UnixNano())
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentErrorsDataSourceConfig(appID),
+				// A new environment has not been deployed yet
+				ExpectError: regexp.MustCompile("Environment development has no deployment"),
+			},
+		},
+	})
+}
+
+func testAccDeploymentErrorsDataSourceConfig(appID string) string {
+	return fmt.Sprintf(`
+resource "humanitec_application" "test" {
+	id   = "%s"
+	name = "deployment-errors-test"
+}
+
+data "humanitec_deployment_errors" "test" {
+	app_id = humanitec_application.test.id
+	env_id = "development"
+}
+`, appID)
+}
+
+func TestLatestDeployment(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+
+	deployments := []client.DeploymentResponse{
+		{Id: "succeeded-old", Status: "succeeded", CreatedAt: now.Add(-time.Hour)},
+		{Id: "failed-newest", Status: "failed", CreatedAt: now},
+		{Id: "succeeded-new", Status: "succeeded", CreatedAt: now.Add(-time.Minute)},
+	}
+
+	deployment, ok := latestDeployment(&deployments)
+	assert.True(ok)
+	assert.Equal("failed-newest", deployment.Id)
+
+	_, ok = latestDeployment(&[]client.DeploymentResponse{})
+	assert.False(ok)
+
+	_, ok = latestDeployment(nil)
+	assert.False(ok)
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DeploymentSetDataSource{}
+
+func NewDeploymentSetDataSource() datasource.DataSource {
+	return &DeploymentSetDataSource{}
+}
+
+// DeploymentSetDataSource defines the data source implementation.
+type DeploymentSetDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// DeploymentSetDataSourceModel describes the data source data model.
+type DeploymentSetDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	AppID       types.String `tfsdk:"app_id"`
+	EnvID       types.String `tfsdk:"env_id"`
+	SetID       types.String `tfsdk:"set_id"`
+	Version     types.Int64  `tfsdk:"version"`
+	ModulesJSON types.String `tfsdk:"modules_json"`
+	SharedJSON  types.String `tfsdk:"shared_json"`
+}
+
+func (d *DeploymentSetDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_set"
+}
+
+func (d *DeploymentSetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A Deployment Set of an application, either by its ID or the one of the latest successful deployment of an environment. Allows to verify what is deployed, e.g. an image tag, in checks before triggering pipelines.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The Application ID.",
+				Required:            true,
+			},
+			"env_id": schema.StringAttribute{
+				MarkdownDescription: "The Environment ID, the Deployment Set of its latest successful deployment is returned.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("env_id"), path.MatchRoot("set_id")),
+				},
+			},
+			"set_id": schema.StringAttribute{
+				MarkdownDescription: "The Deployment Set ID.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "The version of the Deployment Set format.",
+				Computed:            true,
+			},
+			"modules_json": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded modules of the Deployment Set by their ID, including their profile, spec and externals.",
+				Computed:            true,
+			},
+			"shared_json": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded shared Resources of the Deployment Set by their ID.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DeploymentSetDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *DeploymentSetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeploymentSetDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := data.AppID.ValueString()
+	setID := data.SetID.ValueString()
+
+	if envID := data.EnvID.ValueString(); envID != "" {
+		listDeploymentsResp, err := d.client.ListDeploymentsWithResponse(ctx, d.orgId, appID, envID, &client.ListDeploymentsParams{})
+		if err != nil {
+			resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list deployments of environment %s, got error: %s", envID, err))
+			return
+		}
+		if listDeploymentsResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list deployments of environment %s, unexpected status code: %d, body: %s", envID, listDeploymentsResp.StatusCode(), listDeploymentsResp.Body))
+			return
+		}
+
+		deployment, ok := latestSucceededDeployment(listDeploymentsResp.JSON200)
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("env_id"), HUM_INPUT_ERR, fmt.Sprintf("Environment %s has no successful deployment", envID))
+			return
+		}
+		setID = deployment.SetId
+	}
+
+	getSetResp, err := d.client.GetOrgsOrgIdAppsAppIdSetsSetIdWithResponse(ctx, d.orgId, appID, setID, &client.GetOrgsOrgIdAppsAppIdSetsSetIdParams{})
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to get deployment set, got error: %s", err))
+		return
+	}
+	if getSetResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to get deployment set, unexpected status code: %d, body: %s", getSetResp.StatusCode(), getSetResp.Body))
+		return
+	}
+
+	// The response is only a diff if requested, the client doesn't decode either variant
+	var set client.SetResponse
+	if err := json.Unmarshal(getSetResp.Body, &set); err != nil {
+		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to decode deployment set, got error: %s", err))
+		return
+	}
+
+	modulesJSON, err := json.Marshal(set.Modules)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_PROVIDER_ERR, fmt.Sprintf("Unable to encode deployment set modules, got error: %s", err))
+		return
+	}
+	sharedJSON, err := json.Marshal(set.Shared)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_PROVIDER_ERR, fmt.Sprintf("Unable to encode deployment set shared resources, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", appID, set.Id))
+	data.SetID = types.StringValue(set.Id)
+	data.Version = types.Int64Value(int64(set.Version))
+	data.ModulesJSON = types.StringValue(string(modulesJSON))
+	data.SharedJSON = types.StringValue(string(sharedJSON))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDeploymentSetDataSource_NoDeployment(t *testing.T) {
+	appID := fmt.Sprintf("tf-deployment-set-%d", time.Now().UnixNano())
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentSetDataSourceConfig(appID),
+				// A new environment has not been deployed yet
+				ExpectError: regexp.MustCompile("Environment development has no successful deployment"),
+			},
+		},
+	})
+}
+
+func testAccDeploymentSetDataSourceConfig(appID string) string {
+	return fmt.Sprintf(`
+resource "humanitec_application" "test" {
+	id   = "%s"
+	name = "deployment-set-test"
+}
+
+data "humanitec_deployment_set" "test" {
+	app_id = humanitec_application.test.id
+	env_id = "development"
+}
+`, appID)
+}
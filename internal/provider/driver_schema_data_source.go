@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/pagination"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DriverSchemaDataSource{}
+
+func NewDriverSchemaDataSource() datasource.DataSource {
+	return &DriverSchemaDataSource{}
+}
+
+// DriverSchemaDataSource defines the data source implementation.
+type DriverSchemaDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// DriverSchemaDataSourceModel describes the data source data model.
+type DriverSchemaDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	DriverType    types.String `tfsdk:"driver_type"`
+	Type          types.String `tfsdk:"type"`
+	InputsSchema  types.String `tfsdk:"inputs_schema"`
+	OutputsSchema types.String `tfsdk:"outputs_schema"`
+}
+
+func (d *DriverSchemaDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_driver_schema"
+}
+
+func (d *DriverSchemaDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The JSON Schema the inputs of a Resource Driver, or the inputs and outputs of a Resource Type, are validated against, e.g. to validate driver inputs in a module before applying them.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"driver_type": schema.StringAttribute{
+				MarkdownDescription: "The driver type, e.g. `humanitec/s3`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("driver_type"), path.MatchRoot("type")),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The Resource Type, e.g. `s3`.",
+				Optional:            true,
+			},
+			"inputs_schema": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded JSON Schema of the driver inputs or the resource type inputs.",
+				Computed:            true,
+			},
+			"outputs_schema": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded JSON Schema of the resource type outputs, only set for a `type`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DriverSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *DriverSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DriverSchemaDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DriverType.IsNull() {
+		d.readDriverSchema(ctx, &data, resp)
+	} else {
+		d.readResourceTypeSchema(ctx, &data, resp)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readDriverSchema looks up the driver in the list of drivers available to the organization, as it may be defined in another one, e.g. humanitec.
+func (d *DriverSchemaDataSource) readDriverSchema(ctx context.Context, data *DriverSchemaDataSourceModel, resp *datasource.ReadResponse) {
+	driverType := data.DriverType.ValueString()
+
+	drivers, err := pagination.All(ctx, func(ctx context.Context, editor pagination.RequestEditor) ([]client.DriverDefinitionResponse, *http.Response, error) {
+		httpResp, err := d.client.ListResourceDriversWithResponse(ctx, d.orgId, editor)
+		if err != nil {
+			return nil, nil, err
+		}
+		if httpResp.StatusCode() != 200 {
+			return nil, nil, fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+		}
+		if httpResp.JSON200 == nil {
+			return nil, httpResp.HTTPResponse, nil
+		}
+		return *httpResp.JSON200, httpResp.HTTPResponse, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list resource drivers, got error: %s", err))
+		return
+	}
+
+	for _, driver := range drivers {
+		if fmt.Sprintf("%s/%s", driver.OrgId, driver.Id) != driverType {
+			continue
+		}
+
+		data.ID = types.StringValue(driverType)
+		data.InputsSchema = jsonSchemaValue(driver.InputsSchema, &resp.Diagnostics)
+		data.OutputsSchema = types.StringNull()
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(path.Root("driver_type"), HUM_INPUT_ERR, fmt.Sprintf("Resource driver %s isn't available in organization %s", driverType, d.orgId))
+}
+
+func (d *DriverSchemaDataSource) readResourceTypeSchema(ctx context.Context, data *DriverSchemaDataSourceModel, resp *datasource.ReadResponse) {
+	resType := data.Type.ValueString()
+
+	resTypes, err := pagination.All(ctx, func(ctx context.Context, editor pagination.RequestEditor) ([]client.ResourceTypeResponse, *http.Response, error) {
+		httpResp, err := d.client.ListResourceTypesWithResponse(ctx, d.orgId, editor)
+		if err != nil {
+			return nil, nil, err
+		}
+		if httpResp.StatusCode() != 200 {
+			return nil, nil, fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+		}
+		if httpResp.JSON200 == nil {
+			return nil, httpResp.HTTPResponse, nil
+		}
+		return *httpResp.JSON200, httpResp.HTTPResponse, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list resource types, got error: %s", err))
+		return
+	}
+
+	for _, t := range resTypes {
+		if t.Type != resType {
+			continue
+		}
+
+		data.ID = types.StringValue(resType)
+		data.InputsSchema = jsonSchemaValue(t.InputsSchema, &resp.Diagnostics)
+		data.OutputsSchema = jsonSchemaValue(t.OutputsSchema, &resp.Diagnostics)
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(path.Root("type"), HUM_INPUT_ERR, fmt.Sprintf("Resource type %s isn't available in organization %s", resType, d.orgId))
+}
+
+// jsonSchemaValue encodes the JSON Schema returned by the API, it is null if the API returned none.
+func jsonSchemaValue(jsonSchema map[string]interface{}, diags *diag.Diagnostics) types.String {
+	if jsonSchema == nil {
+		return types.StringNull()
+	}
+
+	b, err := json.Marshal(jsonSchema)
+	if err != nil {
+		diags.AddError(HUM_API_ERR, fmt.Sprintf("Failed to marshal JSON Schema: %s", err.Error()))
+		return types.StringNull()
+	}
+
+	return types.StringValue(string(b))
+}
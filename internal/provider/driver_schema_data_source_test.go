@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDriverSchemaDataSource(t *testing.T) {
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "humanitec_driver_schema" "driver" {
+  driver_type = "humanitec/s3"
+}
+
+data "humanitec_driver_schema" "type" {
+  type = "s3"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.humanitec_driver_schema.driver", "id", "humanitec/s3"),
+					resource.TestCheckResourceAttrSet("data.humanitec_driver_schema.driver", "inputs_schema"),
+					resource.TestCheckNoResourceAttr("data.humanitec_driver_schema.driver", "outputs_schema"),
+					resource.TestCheckResourceAttr("data.humanitec_driver_schema.type", "id", "s3"),
+					resource.TestCheckResourceAttrSet("data.humanitec_driver_schema.type", "outputs_schema"),
+				),
+			},
+		},
+	})
+}
+
+func TestJSONSchemaValue(t *testing.T) {
+	assert := assert.New(t)
+
+	var diags diag.Diagnostics
+	assert.Equal(types.StringNull(), jsonSchemaValue(nil, &diags))
+	assert.Equal(
+		types.StringValue(`{"properties":{"region":{"type":"string"}},"type":"object"}`),
+		jsonSchemaValue(map[string]interface{}{"type": "object", "properties": map[string]interface{}{"region": map[string]interface{}{"type": "string"}}}, &diags),
+	)
+	assert.Empty(diags)
+}
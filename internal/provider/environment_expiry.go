@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/pagination"
+)
+
+// environmentExpiresAtKey is the key of the environment scoped shared value the expiry of an environment is recorded in.
+const environmentExpiresAtKey = "TF_ENVIRONMENT_EXPIRES_AT"
+
+const environmentExpiresAtDescription = "Expiry of the environment, recorded by the Humanitec Terraform provider."
+
+// getEnvironmentExpiry returns the recorded expiry of the environment or nil if none is recorded.
+func getEnvironmentExpiry(ctx context.Context, c *humanitec.Client, orgID, appID, envID string) (*string, error) {
+	values, err := pagination.All(ctx, func(ctx context.Context, editor pagination.RequestEditor) ([]client.ValueResponse, *http.Response, error) {
+		httpResp, err := c.GetOrgsOrgIdAppsAppIdEnvsEnvIdValuesWithResponse(ctx, orgID, appID, envID, editor)
+		if err != nil {
+			return nil, nil, err
+		}
+		if httpResp.StatusCode() != 200 {
+			return nil, nil, fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+		}
+		if httpResp.JSON200 == nil {
+			return nil, httpResp.HTTPResponse, nil
+		}
+		return *httpResp.JSON200, httpResp.HTTPResponse, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, value := range values {
+		if value.Key == environmentExpiresAtKey {
+			return &value.Value, nil
+		}
+	}
+	return nil, nil
+}
+
+// setEnvironmentExpiry records the expiry of the environment, previous is the currently recorded expiry.
+func setEnvironmentExpiry(ctx context.Context, c *humanitec.Client, orgID, appID, envID string, expiresAt, previous *string) error {
+	description := environmentExpiresAtDescription
+	isSecret := false
+
+	switch {
+	case expiresAt == nil && previous == nil:
+		return nil
+	case expiresAt == nil:
+		httpResp, err := c.DeleteOrgsOrgIdAppsAppIdEnvsEnvIdValuesKeyWithResponse(ctx, orgID, appID, envID, environmentExpiresAtKey)
+		if err != nil {
+			return err
+		}
+		if httpResp.StatusCode() != 204 && httpResp.StatusCode() != 404 {
+			return fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+		}
+	case previous == nil:
+		httpResp, err := c.PostOrgsOrgIdAppsAppIdEnvsEnvIdValuesWithResponse(ctx, orgID, appID, envID, client.PostOrgsOrgIdAppsAppIdValuesJSONRequestBody{
+			Key:         environmentExpiresAtKey,
+			Value:       expiresAt,
+			Description: &description,
+			IsSecret:    &isSecret,
+		})
+		if err != nil {
+			return err
+		}
+		if httpResp.StatusCode() != 201 {
+			return fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+		}
+	case *expiresAt != *previous:
+		httpResp, err := c.PutOrgsOrgIdAppsAppIdEnvsEnvIdValuesKeyWithResponse(ctx, orgID, appID, envID, environmentExpiresAtKey, client.ValueEditPayloadRequest{
+			Value:       expiresAt,
+			Description: &description,
+			IsSecret:    &isSecret,
+		})
+		if err != nil {
+			return err
+		}
+		if httpResp.StatusCode() != 200 {
+			return fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+		}
+	}
+
+	return nil
+}
+
+// isEnvironmentExpired reports whether the recorded expiry is at or before now, unparsable expiries never expire.
+func isEnvironmentExpired(expiresAt string, now time.Time) bool {
+	t, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false
+	}
+	return !t.After(now)
+}
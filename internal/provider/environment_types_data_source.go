@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/hashcode"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EnvironmentTypesDataSource{}
+
+func NewEnvironmentTypesDataSource() datasource.DataSource {
+	return &EnvironmentTypesDataSource{}
+}
+
+// EnvironmentTypesDataSource defines the data source implementation.
+type EnvironmentTypesDataSource struct {
+	data *HumanitecData
+}
+
+// EnvironmentTypesDataSourceModel describes the data source data model.
+type EnvironmentTypesDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	EnvironmentTypes types.List   `tfsdk:"environment_types"`
+}
+
+// EnvironmentTypeDataSourceModel describes a single environment type.
+type EnvironmentTypeDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Description       types.String `tfsdk:"description"`
+	EnvironmentsCount types.Int64  `tfsdk:"environments_count"`
+}
+
+var environmentTypeAttrTypes = map[string]attr.Type{
+	"id":                 types.StringType,
+	"description":        types.StringType,
+	"environments_count": types.Int64Type,
+}
+
+func (d *EnvironmentTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_types"
+}
+
+func (d *EnvironmentTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Environment Types of the organization with the number of environments using them, e.g. to find unused types that can be deleted. Counting requires listing the environments of every application.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"environment_types": schema.ListAttribute{
+				MarkdownDescription: "List of environment types with their `id`, `description` and `environments_count` across all applications.",
+				ElementType: types.ObjectType{
+					AttrTypes: environmentTypeAttrTypes,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *EnvironmentTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.data = resdata
+}
+
+func (d *EnvironmentTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EnvironmentTypesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := d.data.Client.ListEnvironmentTypesWithResponse(ctx, d.data.OrgID)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list environment types, got error: %s", err))
+		return
+	}
+	if httpResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list environment types, unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	usage, err := d.data.countEnvironmentsByType(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to count environments per type, got error: %s", err))
+		return
+	}
+
+	envTypes := []EnvironmentTypeDataSourceModel{}
+	if httpResp.JSON200 != nil {
+		for _, envType := range *httpResp.JSON200 {
+			envTypes = append(envTypes, EnvironmentTypeDataSourceModel{
+				ID:                types.StringValue(envType.Id),
+				Description:       types.StringValue(envType.Description),
+				EnvironmentsCount: types.Int64Value(usage[envType.Id]),
+			})
+		}
+	}
+	sort.Slice(envTypes, func(i, j int) bool {
+		return envTypes[i].ID.ValueString() < envTypes[j].ID.ValueString()
+	})
+
+	envTypeIds := []string{}
+	items := []basetypes.ObjectValue{}
+	for _, envType := range envTypes {
+		item, diags := types.ObjectValueFrom(ctx, environmentTypeAttrTypes, &envType)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		envTypeIds = append(envTypeIds, envType.ID.ValueString())
+		items = append(items, item)
+	}
+
+	envTypeList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: environmentTypeAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.EnvironmentTypes = envTypeList
+	data.ID = types.StringValue(hashcode.Strings(envTypeIds))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEnvironmentTypesDataSource(t *testing.T) {
+	envTypeID := fmt.Sprintf("env-types-test-%d", time.Now().UnixNano())
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentTypesDataSourceConfig(envTypeID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.humanitec_environment_types.test", "environment_types.*", map[string]string{
+						"id":                 envTypeID,
+						"description":        "unused",
+						"environments_count": "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentTypesDataSourceConfig(envTypeID string) string {
+	return fmt.Sprintf(`
+resource "humanitec_environment_type" "test" {
+	id          = "%s"
+	description = "unused"
+}
+
+data "humanitec_environment_types" "test" {
+	depends_on = [humanitec_environment_type.test]
+}
+`, envTypeID)
+}
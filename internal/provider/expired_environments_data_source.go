@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/hashcode"
+	"github.com/humanitec/terraform-provider-humanitec/internal/pagination"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ExpiredEnvironmentsDataSource{}
+
+func NewExpiredEnvironmentsDataSource() datasource.DataSource {
+	return &ExpiredEnvironmentsDataSource{}
+}
+
+// ExpiredEnvironmentsDataSource defines the data source implementation.
+type ExpiredEnvironmentsDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// ExpiredEnvironmentsDataSourceModel describes the data source data model.
+type ExpiredEnvironmentsDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	AppID        types.String `tfsdk:"app_id"`
+	Environments types.List   `tfsdk:"environments"`
+}
+
+// ExpiredEnvironmentDataSourceModel describes a single expired environment.
+type ExpiredEnvironmentDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+var expiredEnvironmentAttrTypes = map[string]attr.Type{
+	"id":         types.StringType,
+	"name":       types.StringType,
+	"type":       types.StringType,
+	"expires_at": types.StringType,
+}
+
+func (d *ExpiredEnvironmentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_expired_environments"
+}
+
+func (d *ExpiredEnvironmentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Environments of an application whose `expires_at`, as recorded by `humanitec_environment`, has passed. Useful for a cleanup workspace destroying stale preview environments.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The Application ID.",
+				Required:            true,
+			},
+			"environments": schema.ListAttribute{
+				MarkdownDescription: "List of expired environments with their `id`, `name`, `type` and `expires_at`.",
+				ElementType: types.ObjectType{
+					AttrTypes: expiredEnvironmentAttrTypes,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *ExpiredEnvironmentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *ExpiredEnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ExpiredEnvironmentsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := data.AppID.ValueString()
+	envs, err := pagination.All(ctx, func(ctx context.Context, editor pagination.RequestEditor) ([]client.EnvironmentResponse, *http.Response, error) {
+		httpResp, err := d.client.ListEnvironmentsWithResponse(ctx, d.orgId, appID, editor)
+		if err != nil {
+			return nil, nil, err
+		}
+		if httpResp.StatusCode() != 200 {
+			return nil, nil, fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+		}
+		if httpResp.JSON200 == nil {
+			return nil, httpResp.HTTPResponse, nil
+		}
+		return *httpResp.JSON200, httpResp.HTTPResponse, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list environments, got error: %s", err))
+		return
+	}
+
+	now := time.Now()
+	envIds := []string{}
+	expired := []basetypes.ObjectValue{}
+	for _, env := range envs {
+		expiresAt, err := getEnvironmentExpiry(ctx, d.client, d.orgId, appID, env.Id)
+		if err != nil {
+			resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to read expiry of environment %s, got error: %s", env.Id, err))
+			return
+		}
+		if expiresAt == nil || !isEnvironmentExpired(*expiresAt, now) {
+			continue
+		}
+
+		item, diags := types.ObjectValueFrom(ctx, expiredEnvironmentAttrTypes, &ExpiredEnvironmentDataSourceModel{
+			ID:        types.StringValue(env.Id),
+			Name:      types.StringValue(env.Name),
+			Type:      types.StringValue(env.Type),
+			ExpiresAt: types.StringValue(*expiresAt),
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		envIds = append(envIds, env.Id)
+		expired = append(expired, item)
+	}
+
+	envList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: expiredEnvironmentAttrTypes}, expired)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Environments = envList
+	data.ID = types.StringValue(hashcode.Strings(append([]string{appID}, envIds...)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccExpiredEnvironmentsDataSource(t *testing.T) {
+	appID := fmt.Sprintf("expired-envs-test-%d", time.Now().UnixNano())
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExpiredEnvironmentsDataSourceConfig(appID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("humanitec_environment.expired", "expires_at", "2020-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.humanitec_expired_environments.test", "environments.#", "1"),
+					resource.TestCheckResourceAttr("data.humanitec_expired_environments.test", "environments.0.id", "expired"),
+				),
+			},
+		},
+	})
+}
+
+func testAccExpiredEnvironmentsDataSourceConfig(appID string) string {
+	return fmt.Sprintf(`
+resource "humanitec_application" "test" {
+	id   = "%s"
+	name = "expired-envs-test"
+}
+
+resource "humanitec_environment" "expired" {
+	app_id     = humanitec_application.test.id
+	id         = "expired"
+	name       = "Expired"
+	type       = "development"
+	expires_at = "2020-01-01T00:00:00Z"
+}
+
+resource "humanitec_environment" "active" {
+	app_id     = humanitec_application.test.id
+	id         = "active"
+	name       = "Active"
+	type       = "development"
+	expires_at = "2100-01-01T00:00:00Z"
+}
+
+data "humanitec_expired_environments" "test" {
+	app_id = humanitec_application.test.id
+
+	depends_on = [humanitec_environment.expired, humanitec_environment.active]
+}
+`, appID)
+}
+
+func TestIsEnvironmentExpired(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2024, 1, 31, 18, 0, 0, 0, time.UTC)
+
+	assert.True(isEnvironmentExpired("2024-01-31T17:59:59Z", now))
+	assert.True(isEnvironmentExpired("2024-01-31T18:00:00Z", now))
+	assert.True(isEnvironmentExpired("2024-01-31T19:00:00+02:00", now))
+	assert.False(isEnvironmentExpired("2024-01-31T18:00:01Z", now))
+	assert.False(isEnvironmentExpired("not a timestamp", now))
+}
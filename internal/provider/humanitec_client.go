@@ -14,9 +14,11 @@ const (
 
 func NewHumanitecClient(host, token, version string, doer client.HttpRequestDoer) (*humanitec.Client, error) {
 	client, err := humanitec.NewClient(&humanitec.Config{
-		Token:       token,
-		URL:         host,
-		InternalApp: fmt.Sprintf("%s/%s", app, version),
+		Token: token,
+		// A missing token is reported by the provider configuration, with skip_api_validation only once the API is called
+		SkipInitialTokenCheck: true,
+		URL:                   host,
+		InternalApp:           fmt.Sprintf("%s/%s", app, version),
 		RequestLogger: func(req *humanitec.RequestDetails) {
 			tflog.Debug(req.Context, "api req", map[string]interface{}{"method": req.Method, "uri": req.URL.String(), "body": string(req.Body)})
 		},
@@ -1,10 +1,496 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/pagination"
 )
 
 type HumanitecData struct {
 	Client *humanitec.Client
 	OrgID  string
+	// APIUsage records the API usage of the Client.
+	APIUsage *apiUsageRecorder
+
+	// ValidateReferences enables plan time checks that referenced objects exist.
+	ValidateReferences bool
+	// WarnPlaintextSecrets enables plan time warnings when plaintext secrets are used while the primary secret store is external.
+	WarnPlaintextSecrets bool
+	// AllowForceDelete allows resources to be planned with force_delete enabled.
+	AllowForceDelete bool
+	// DetectMovedApplications enables looking up missing applications in the other organizations accessible with the token.
+	DetectMovedApplications bool
+	// ConfirmDestructiveViaAPI enables plan time checks that the token has the organization role required for destructive operations.
+	ConfirmDestructiveViaAPI bool
+	// DefaultAppPrefix is the prefix the ids of created applications have to start with.
+	DefaultAppPrefix string
+	// IDNamingConvention is the pattern the ids of created applications, environments and resource definitions have to match.
+	IDNamingConvention *regexp.Regexp
+	// OperationMetrics records the API calls, retries and time of resource operations, nil if operation_metrics isn't enabled.
+	OperationMetrics *operationMetricsRecorder
+	// RecordMode is "plan-only" if resource operations record their requests changing objects instead of sending them.
+	RecordMode string
+
+	recordedOperations atomic.Int64
+
+	appIDs             cachedLookup[map[string]bool]
+	envTypeUsage       cachedLookup[map[string]int64]
+	orgIDs             cachedLookup[[]string]
+	orgRole            cachedLookup[string]
+	primarySecretStore cachedLookup[*client.SecretStoreResponse]
+}
+
+// ProviderDataTypeError is returned when resources or data sources are configured with provider data of an unexpected type.
+type ProviderDataTypeError struct {
+	ProviderData any
+}
+
+func (e *ProviderDataTypeError) Error() string {
+	return fmt.Sprintf("Expected *HumanitecData, got: %T. Please report this issue to the provider developers.", e.ProviderData)
+}
+
+// configureFromProviderData returns the HumanitecData passed to the Configure method of resources and data sources,
+// it is nil if the provider has not been configured yet.
+func configureFromProviderData(providerData any) (*HumanitecData, error) {
+	if providerData == nil {
+		return nil, nil
+	}
+
+	data, ok := providerData.(*HumanitecData)
+	if !ok {
+		return nil, &ProviderDataTypeError{ProviderData: providerData}
+	}
+
+	return data, nil
+}
+
+// cachedLookup caches the result of a lookup for the lifetime of the provider. Only successful results are cached, so
+// that a transient error or the cancelled context of one caller isn't returned to all later ones.
+type cachedLookup[T any] struct {
+	mu     sync.Mutex
+	loaded bool
+	value  T
+}
+
+func (c *cachedLookup[T]) get(ctx context.Context, lookup func(ctx context.Context) (T, error)) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.loaded {
+		return c.value, nil
+	}
+
+	value, err := lookup(ctx)
+	if err != nil {
+		return value, err
+	}
+
+	c.value, c.loaded = value, true
+	return value, nil
+}
+
+// listAppIDs returns the ids of all applications in the organization, the list is cached after the first successful lookup.
+func (d *HumanitecData) listAppIDs(ctx context.Context) (map[string]bool, error) {
+	return d.appIDs.get(ctx, func(ctx context.Context) (map[string]bool, error) {
+		apps, err := pagination.All(ctx, func(ctx context.Context, editor pagination.RequestEditor) ([]client.ApplicationResponse, *http.Response, error) {
+			httpResp, err := d.Client.ListApplicationsWithResponse(ctx, d.OrgID, editor)
+			if err != nil {
+				return nil, nil, err
+			}
+			if httpResp.StatusCode() != 200 {
+				return nil, nil, fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+			}
+			if httpResp.JSON200 == nil {
+				return nil, httpResp.HTTPResponse, nil
+			}
+			return *httpResp.JSON200, httpResp.HTTPResponse, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		appIDs := map[string]bool{}
+		for _, app := range apps {
+			appIDs[app.Id] = true
+		}
+		return appIDs, nil
+	})
+}
+
+// countEnvironmentsByType returns the number of environments of each type across all applications in the organization,
+// the counts are cached after the first successful lookup.
+func (d *HumanitecData) countEnvironmentsByType(ctx context.Context) (map[string]int64, error) {
+	return d.envTypeUsage.get(ctx, func(ctx context.Context) (map[string]int64, error) {
+		appIDs, err := d.listAppIDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var envs []client.EnvironmentResponse
+		for appID := range appIDs {
+			appEnvs, err := pagination.All(ctx, func(ctx context.Context, editor pagination.RequestEditor) ([]client.EnvironmentResponse, *http.Response, error) {
+				httpResp, err := d.Client.ListEnvironmentsWithResponse(ctx, d.OrgID, appID, editor)
+				if err != nil {
+					return nil, nil, err
+				}
+				if httpResp.StatusCode() != 200 {
+					return nil, nil, fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+				}
+				if httpResp.JSON200 == nil {
+					return nil, httpResp.HTTPResponse, nil
+				}
+				return *httpResp.JSON200, httpResp.HTTPResponse, nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("listing environments of application %s: %w", appID, err)
+			}
+			envs = append(envs, appEnvs...)
+		}
+
+		return environmentTypeUsage(envs), nil
+	})
+}
+
+// environmentTypeUsage counts the environments per environment type.
+func environmentTypeUsage(envs []client.EnvironmentResponse) map[string]int64 {
+	usage := map[string]int64{}
+	for _, env := range envs {
+		usage[env.Type]++
+	}
+	return usage
+}
+
+// listOrgIDs returns the ids of all organizations accessible with the token, the list is cached after the first successful lookup.
+func (d *HumanitecData) listOrgIDs(ctx context.Context) ([]string, error) {
+	return d.orgIDs.get(ctx, func(ctx context.Context) ([]string, error) {
+		httpResp, err := d.Client.ListOrganizationsWithResponse(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if httpResp.StatusCode() != 200 {
+			return nil, fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+		}
+
+		var orgIDs []string
+		if httpResp.JSON200 != nil {
+			for _, org := range *httpResp.JSON200 {
+				orgIDs = append(orgIDs, org.Id)
+			}
+		}
+		return orgIDs, nil
+	})
+}
+
+// findMovedApplication returns the id of another accessible organization containing the application or an empty string if there is none.
+func (d *HumanitecData) findMovedApplication(ctx context.Context, appID string) (string, error) {
+	if d == nil || !d.DetectMovedApplications {
+		return "", nil
+	}
+
+	orgIDs, err := d.listOrgIDs(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, orgID := range orgIDs {
+		if orgID == d.OrgID {
+			continue
+		}
+
+		httpResp, err := d.Client.GetApplicationWithResponse(ctx, orgID, appID)
+		if err != nil {
+			return "", err
+		}
+		switch httpResp.StatusCode() {
+		case 200:
+			return orgID, nil
+		case 403, 404:
+			continue
+		default:
+			return "", fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+		}
+	}
+
+	return "", nil
+}
+
+// validateAppReference ensures the app_id of a planned resource references an existing application.
+func (d *HumanitecData) validateAppReference(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip destroy plans and when the validation isn't enabled
+	if d == nil || !d.ValidateReferences || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var appID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("app_id"), &appID)...)
+	if resp.Diagnostics.HasError() || appID.IsNull() || appID.IsUnknown() {
+		return
+	}
+
+	appIDs, err := d.listAppIDs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list applications to validate references, got error: %s", err))
+		return
+	}
+
+	if !appIDs[appID.ValueString()] {
+		resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			path.Root("app_id"),
+			HUM_INPUT_ERR,
+			fmt.Sprintf("Application %q doesn't exist in organization %q.", appID.ValueString(), d.OrgID),
+		))
+	}
+}
+
+// validateForceDelete ensures force_delete is only enabled when allowed by the provider configuration.
+func (d *HumanitecData) validateForceDelete(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip destroy plans and when the provider has not been configured
+	if d == nil || d.AllowForceDelete || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var forceDelete types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("force_delete"), &forceDelete)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if forceDelete.ValueBool() {
+		resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			path.Root("force_delete"),
+			HUM_INPUT_ERR,
+			"force_delete can't be enabled unless allow_force_delete is set in the provider configuration.",
+		))
+	}
+}
+
+// validateSecretValueDestroy warns about every secret value that is destroyed or replaced by the plan, values protected by
+// prevent_destroy_secret can only be destroyed if allow_force_delete is set in the provider configuration. A value is
+// replaced if the plan changes one of its replaceAttributes.
+func (d *HumanitecData) validateSecretValueDestroy(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, replaceAttributes path.Paths) {
+	// Skip creates and when the provider has not been configured
+	if d == nil || req.State.Raw.IsNull() {
+		return
+	}
+
+	action := "destroyed"
+	if !req.Plan.Raw.IsNull() {
+		replaced, diags := planChangesAttributes(ctx, req, replaceAttributes)
+		resp.Diagnostics.Append(diags...)
+		// Skip in-place updates
+		if resp.Diagnostics.HasError() || !replaced {
+			return
+		}
+		action = "replaced"
+	}
+
+	var id types.String
+	var isSecret, preventDestroySecret types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("is_secret"), &isSecret)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("prevent_destroy_secret"), &preventDestroySecret)...)
+	if resp.Diagnostics.HasError() || !isSecret.ValueBool() {
+		return
+	}
+
+	if preventDestroySecret.ValueBool() && !d.AllowForceDelete {
+		resp.Diagnostics.AddError(HUM_INPUT_ERR, fmt.Sprintf(
+			"Secret value %s would be %s, but it's protected by prevent_destroy_secret. Apply prevent_destroy_secret = false first or set allow_force_delete in the provider configuration.",
+			id.ValueString(), action,
+		))
+		return
+	}
+
+	// All warnings share the same summary, so Terraform lists every destroyed secret value together
+	resp.Diagnostics.AddWarning("Secret values destroyed", fmt.Sprintf("Secret value %s will be %s.", id.ValueString(), action))
+}
+
+// planChangesAttributes reports whether the plan changes any of the attributes. The framework only adds the attributes
+// requiring a replacement to the response after ModifyPlan of the resource, so their planned values are compared with
+// the state instead.
+func planChangesAttributes(ctx context.Context, req resource.ModifyPlanRequest, attributes path.Paths) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	for _, attribute := range attributes {
+		var planValue, stateValue attr.Value
+		diags.Append(req.Plan.GetAttribute(ctx, attribute, &planValue)...)
+		diags.Append(req.State.GetAttribute(ctx, attribute, &stateValue)...)
+		if diags.HasError() {
+			return false, diags
+		}
+		if !planValue.Equal(stateValue) {
+			return true, diags
+		}
+	}
+	return false, diags
+}
+
+// checkIDConvention ensures id starts with prefix and matches the naming convention, both are optional.
+func checkIDConvention(id, prefix string, convention *regexp.Regexp) error {
+	if prefix != "" && !strings.HasPrefix(id, prefix) {
+		return fmt.Errorf("it has to start with %q as configured by default_app_prefix in the provider configuration", prefix)
+	}
+	if convention != nil && !convention.MatchString(id) {
+		return fmt.Errorf("it has to match %q as configured by id_naming_convention in the provider configuration", convention.String())
+	}
+	return nil
+}
+
+// validateIDConvention ensures the id of a created resource follows the naming convention of the provider configuration,
+// the ids of applications also have to start with the configured default_app_prefix.
+func (d *HumanitecData) validateIDConvention(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, resourceName string) {
+	// Skip destroy plans and when the provider has not been configured
+	if d == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	prefix := ""
+	if resourceName == "application" {
+		prefix = d.DefaultAppPrefix
+	}
+	if prefix == "" && d.IDNamingConvention == nil {
+		return
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() || id.IsNull() || id.IsUnknown() {
+		return
+	}
+
+	// Only created resources are checked, so that introducing a convention doesn't block existing ones
+	if !req.State.Raw.IsNull() {
+		var stateID types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &stateID)...)
+		if resp.Diagnostics.HasError() || stateID.Equal(id) {
+			return
+		}
+	}
+
+	if err := checkIDConvention(id.ValueString(), prefix, d.IDNamingConvention); err != nil {
+		resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			path.Root("id"),
+			HUM_INPUT_ERR,
+			fmt.Sprintf("The %s id %q doesn't follow the naming convention of the organization, %s.", resourceName, id.ValueString(), err),
+		))
+	}
+}
+
+// destructiveOperationRoles are the organization roles allowed to delete or force delete resource definitions and their
+// criteria. The Administrator and Manager roles may manage resource definitions, see the organization roles in the
+// role-based access control section of the Humanitec docs (https://developer.humanitec.com).
+var destructiveOperationRoles = map[string]bool{
+	"administrator": true,
+	"manager":       true,
+}
+
+// getOrgRole returns the organization role of the user the token belongs to, the role is cached after the first successful lookup.
+func (d *HumanitecData) getOrgRole(ctx context.Context) (string, error) {
+	return d.orgRole.get(ctx, func(ctx context.Context) (string, error) {
+		userResp, err := d.Client.GetCurrentUserWithResponse(ctx)
+		if err != nil {
+			return "", err
+		}
+		if userResp.StatusCode() != 200 || userResp.JSON200 == nil {
+			return "", fmt.Errorf("unexpected status code: %d, body: %s", userResp.StatusCode(), userResp.Body)
+		}
+
+		rolesResp, err := d.Client.ListUserRolesInOrgWithResponse(ctx, d.OrgID)
+		if err != nil {
+			return "", err
+		}
+		if rolesResp.StatusCode() != 200 {
+			return "", fmt.Errorf("unexpected status code: %d, body: %s", rolesResp.StatusCode(), rolesResp.Body)
+		}
+
+		if rolesResp.JSON200 != nil {
+			for _, userRole := range *rolesResp.JSON200 {
+				if userRole.Id == userResp.JSON200.Id {
+					return userRole.Role, nil
+				}
+			}
+		}
+		return "", fmt.Errorf("user %s has no role in organization %s", userResp.JSON200.Id, d.OrgID)
+	})
+}
+
+// validateDestructiveRole ensures the token is allowed to delete the resource when the plan destroys or replaces it, so
+// that an apply doesn't fail halfway.
+func (d *HumanitecData) validateDestructiveRole(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, resourceName string, replaceAttributes path.Paths) {
+	// Skip creates and when the check isn't enabled
+	if d == nil || !d.ConfirmDestructiveViaAPI || req.State.Raw.IsNull() {
+		return
+	}
+
+	if !req.Plan.Raw.IsNull() {
+		replaced, diags := planChangesAttributes(ctx, req, replaceAttributes)
+		resp.Diagnostics.Append(diags...)
+		// Skip in-place updates
+		if resp.Diagnostics.HasError() || !replaced {
+			return
+		}
+	}
+
+	var forceDelete types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("force_delete"), &forceDelete)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := "deleting"
+	if forceDelete.ValueBool() {
+		operation = "force deleting"
+	}
+	if !req.Plan.Raw.IsNull() {
+		operation = "replacing"
+	}
+
+	role, err := d.getOrgRole(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to look up the organization role of the token, got error: %s", err))
+		return
+	}
+
+	if !destructiveOperationRoles[role] {
+		resp.Diagnostics.AddError(
+			HUM_INPUT_ERR,
+			fmt.Sprintf("The token has the %q role in organization %q, %s a %s requires the administrator or manager role.", role, d.OrgID, operation, resourceName),
+		)
+	}
+}
+
+// getPrimarySecretStore returns the primary secret store of the organization or nil if there is none, the result is
+// cached after the first successful lookup.
+func (d *HumanitecData) getPrimarySecretStore(ctx context.Context) (*client.SecretStoreResponse, error) {
+	return d.primarySecretStore.get(ctx, func(ctx context.Context) (*client.SecretStoreResponse, error) {
+		httpResp, err := d.Client.GetOrgsOrgIdSecretstoresWithResponse(ctx, d.OrgID)
+		if err != nil {
+			return nil, err
+		}
+		if httpResp.StatusCode() != 200 {
+			return nil, fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+		}
+
+		if httpResp.JSON200 != nil {
+			for _, store := range *httpResp.JSON200 {
+				if store.Primary {
+					return &store, nil
+				}
+			}
+		}
+		return nil, nil
+	})
 }
@@ -0,0 +1,495 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHumanitecDataListAppIDs(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal("/orgs/test-org/apps", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id": "app-a", "name": "App A"}, {"id": "app-b", "name": "App B"}]`)
+	}))
+	defer srv.Close()
+
+	client, err := NewHumanitecClient(srv.URL, "TEST_TOKEN", "test", &http.Client{})
+	assert.NoError(err)
+
+	data := &HumanitecData{
+		Client:             client,
+		OrgID:              "test-org",
+		ValidateReferences: true,
+	}
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		appIDs, err := data.listAppIDs(ctx)
+		assert.NoError(err)
+		assert.Equal(map[string]bool{"app-a": true, "app-b": true}, appIDs)
+	}
+	assert.Equal(1, calls)
+}
+
+func TestHumanitecDataListAppIDsRetriesErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id": "app-a", "name": "App A"}]`)
+	}))
+	defer srv.Close()
+
+	client, err := NewHumanitecClient(srv.URL, "TEST_TOKEN", "test", &http.Client{})
+	assert.NoError(err)
+
+	data := &HumanitecData{
+		Client: client,
+		OrgID:  "test-org",
+	}
+
+	ctx := context.Background()
+	_, err = data.listAppIDs(ctx)
+	assert.Error(err)
+
+	for i := 0; i < 2; i++ {
+		appIDs, err := data.listAppIDs(ctx)
+		assert.NoError(err)
+		assert.Equal(map[string]bool{"app-a": true}, appIDs)
+	}
+	assert.Equal(2, calls)
+}
+
+func TestHumanitecDataCountEnvironmentsByType(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/orgs/test-org/apps":
+			fmt.Fprint(w, `[{"id": "app-a", "name": "App A"}, {"id": "app-b", "name": "App B"}]`)
+		case "/orgs/test-org/apps/app-a/envs":
+			fmt.Fprint(w, `[{"id": "dev", "name": "Dev", "type": "development"}, {"id": "prod", "name": "Prod", "type": "production"}]`)
+		case "/orgs/test-org/apps/app-b/envs":
+			fmt.Fprint(w, `[{"id": "dev", "name": "Dev", "type": "development"}]`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewHumanitecClient(srv.URL, "TEST_TOKEN", "test", &http.Client{})
+	assert.NoError(err)
+
+	data := &HumanitecData{
+		Client: client,
+		OrgID:  "test-org",
+	}
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		usage, err := data.countEnvironmentsByType(ctx)
+		assert.NoError(err)
+		assert.Equal(map[string]int64{"development": 2, "production": 1}, usage)
+	}
+	assert.Equal(3, calls)
+}
+
+func TestHumanitecDataGetPrimarySecretStore(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal("/orgs/test-org/secretstores", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id": "humanitec", "primary": false, "humanitec": {}}, {"id": "vault-store", "primary": true, "vault": {"url": "https://vault.example.com"}}]`)
+	}))
+	defer srv.Close()
+
+	client, err := NewHumanitecClient(srv.URL, "TEST_TOKEN", "test", &http.Client{})
+	assert.NoError(err)
+
+	data := &HumanitecData{
+		Client:               client,
+		OrgID:                "test-org",
+		WarnPlaintextSecrets: true,
+	}
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		store, err := data.getPrimarySecretStore(ctx)
+		assert.NoError(err)
+		assert.Equal("vault-store", store.Id)
+		assert.Nil(store.Humanitec)
+	}
+	assert.Equal(1, calls)
+}
+
+func TestHumanitecDataFindMovedApplication(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/orgs":
+			fmt.Fprint(w, `[{"id": "test-org", "name": "Test"}, {"id": "other-org", "name": "Other"}, {"id": "new-org", "name": "New"}]`)
+		case "/orgs/new-org/apps/moved-app":
+			fmt.Fprint(w, `{"id": "moved-app", "name": "Moved App"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{}`)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewHumanitecClient(srv.URL, "TEST_TOKEN", "test", &http.Client{})
+	assert.NoError(err)
+
+	ctx := context.Background()
+
+	data := &HumanitecData{
+		Client: client,
+		OrgID:  "test-org",
+	}
+	orgID, err := data.findMovedApplication(ctx, "moved-app")
+	assert.NoError(err)
+	assert.Equal("", orgID, "lookup is disabled by default")
+
+	data.DetectMovedApplications = true
+
+	orgID, err = data.findMovedApplication(ctx, "moved-app")
+	assert.NoError(err)
+	assert.Equal("new-org", orgID)
+
+	orgID, err = data.findMovedApplication(ctx, "deleted-app")
+	assert.NoError(err)
+	assert.Equal("", orgID)
+}
+
+func TestHumanitecDataValidateForceDelete(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&ResourceDefinitionCriteriaResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	assert.False(t, schemaResp.Diagnostics.HasError())
+
+	newPlan := func(forceDelete bool) tfsdk.Plan {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		assert.False(t, plan.SetAttribute(ctx, path.Root("force_delete"), forceDelete).HasError())
+		return plan
+	}
+
+	tests := []struct {
+		name        string
+		data        *HumanitecData
+		forceDelete bool
+		expectError bool
+	}{
+		{name: "not configured", data: nil, forceDelete: true},
+		{name: "disabled", data: &HumanitecData{}, forceDelete: false},
+		{name: "not allowed", data: &HumanitecData{}, forceDelete: true, expectError: true},
+		{name: "allowed", data: &HumanitecData{AllowForceDelete: true}, forceDelete: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := newPlan(tc.forceDelete)
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			tc.data.validateForceDelete(ctx, resource.ModifyPlanRequest{Plan: plan}, resp)
+			assert.Equal(t, tc.expectError, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestHumanitecDataValidateIDConvention(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&ResourceApplication{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	assert.False(t, schemaResp.Diagnostics.HasError())
+
+	nullValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+	newPlan := func(id string) tfsdk.Plan {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullValue}
+		assert.False(t, plan.SetAttribute(ctx, path.Root("id"), id).HasError())
+		return plan
+	}
+	newState := func(id string) tfsdk.State {
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: nullValue}
+		if id != "" {
+			assert.False(t, state.SetAttribute(ctx, path.Root("id"), id).HasError())
+		}
+		return state
+	}
+
+	convention := regexp.MustCompile("^[a-z]+-(dev|prod)$")
+
+	tests := []struct {
+		name         string
+		data         *HumanitecData
+		resourceName string
+		id           string
+		stateID      string
+		expectError  bool
+	}{
+		{name: "not configured", data: nil, resourceName: "application", id: "Invalid"},
+		{name: "no convention", data: &HumanitecData{}, resourceName: "application", id: "Invalid"},
+		{name: "matching convention", data: &HumanitecData{IDNamingConvention: convention}, resourceName: "environment", id: "team-dev"},
+		{name: "not matching convention", data: &HumanitecData{IDNamingConvention: convention}, resourceName: "environment", id: "team-test", expectError: true},
+		{name: "existing resource", data: &HumanitecData{IDNamingConvention: convention}, resourceName: "environment", id: "team-test", stateID: "team-test"},
+		{name: "renamed resource", data: &HumanitecData{IDNamingConvention: convention}, resourceName: "environment", id: "team-test", stateID: "team-dev", expectError: true},
+		{name: "app prefix", data: &HumanitecData{DefaultAppPrefix: "team-"}, resourceName: "application", id: "team-dev"},
+		{name: "missing app prefix", data: &HumanitecData{DefaultAppPrefix: "team-"}, resourceName: "application", id: "other-dev", expectError: true},
+		{name: "app prefix ignored for other resources", data: &HumanitecData{DefaultAppPrefix: "team-"}, resourceName: "resource definition", id: "other-dev"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := newPlan(tc.id)
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			tc.data.validateIDConvention(ctx, resource.ModifyPlanRequest{Plan: plan, State: newState(tc.stateID)}, resp, tc.resourceName)
+			assert.Equal(t, tc.expectError, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		})
+	}
+}
+
+func TestHumanitecDataValidateDestructiveRole(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&ResourceDefinitionCriteriaResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	assert.False(t, schemaResp.Diagnostics.HasError())
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	criteria := func(resID string, forceDelete bool) tftypes.Value {
+		return testObjectValue(objectType, map[string]tftypes.Value{
+			"id":                     tftypes.NewValue(tftypes.String, "criteria-id"),
+			"resource_definition_id": tftypes.NewValue(tftypes.String, "def-id"),
+			"res_id":                 tftypes.NewValue(tftypes.String, resID),
+			"force_delete":           tftypes.NewValue(tftypes.Bool, forceDelete),
+		})
+	}
+	null := tftypes.NewValue(objectType, nil)
+
+	newData := func(t *testing.T, role string) *HumanitecData {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/current-user":
+				fmt.Fprint(w, `{"id": "user-a", "name": "User A"}`)
+			case "/orgs/test-org/users":
+				fmt.Fprintf(w, `[{"id": "user-b", "role": "administrator"}, {"id": "user-a", "role": %q}]`, role)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		t.Cleanup(srv.Close)
+
+		client, err := NewHumanitecClient(srv.URL, "TEST_TOKEN", "test", &http.Client{})
+		assert.NoError(t, err)
+
+		return &HumanitecData{Client: client, OrgID: "test-org", ConfirmDestructiveViaAPI: true}
+	}
+
+	tests := []struct {
+		name        string
+		enabled     bool
+		role        string
+		state       tftypes.Value
+		plan        tftypes.Value
+		expectError bool
+	}{
+		{name: "disabled", enabled: false, role: "member", state: criteria("res", false), plan: null},
+		{name: "force delete as member", enabled: true, role: "member", state: criteria("res", true), plan: null, expectError: true},
+		{name: "replace as member", enabled: true, role: "member", state: criteria("res", false), plan: criteria("other-res", false), expectError: true},
+		{name: "create force delete as member", enabled: true, role: "member", state: null, plan: criteria("res", true)},
+		{name: "update force delete as member", enabled: true, role: "member", state: criteria("res", false), plan: criteria("res", true)},
+		{name: "unchanged force delete as member", enabled: true, role: "member", state: criteria("res", true), plan: criteria("res", true)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data := newData(t, tc.role)
+			data.ConfirmDestructiveViaAPI = tc.enabled
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tc.state},
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: tc.plan},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			data.validateDestructiveRole(ctx, req, resp, "resource definition criteria", resourceDefinitionCriteriaReplaceAttributes)
+			assert.Equal(t, tc.expectError, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		})
+	}
+
+	roles := []struct {
+		role    string
+		allowed bool
+	}{
+		{role: "administrator", allowed: true},
+		{role: "manager", allowed: true},
+		{role: "member", allowed: false},
+		{role: "artefactContributor", allowed: false},
+	}
+
+	for _, tc := range roles {
+		t.Run("delete as "+tc.role, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: criteria("res", false)},
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: null},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			newData(t, tc.role).validateDestructiveRole(ctx, req, resp, "resource definition criteria", resourceDefinitionCriteriaReplaceAttributes)
+			assert.Equal(t, !tc.allowed, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		})
+	}
+}
+
+// testObjectValue returns an object of the type with the given attributes, all others are null.
+func testObjectValue(objectType tftypes.Object, attributes map[string]tftypes.Value) tftypes.Value {
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		if v, ok := attributes[name]; ok {
+			values[name] = v
+		} else {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+// planValueChange plans the change of a humanitec_value from its prior state to the config through the provider server
+// like Terraform does, so that the plan modifiers of its attributes run before ModifyPlan of the resource. A nil config
+// plans its destroy.
+func planValueChange(t *testing.T, allowForceDelete bool, prior, config map[string]tftypes.Value) *tfprotov6.PlanResourceChangeResponse {
+	ctx := context.Background()
+	clearProviderEnv(t)
+
+	server := providerserver.NewProtocol6(New("test")())()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	assert.NoError(t, err)
+
+	dynamicValue := func(objectType tftypes.Object, value tftypes.Value) *tfprotov6.DynamicValue {
+		dv, err := tfprotov6.NewDynamicValue(objectType, value)
+		assert.NoError(t, err)
+		return &dv
+	}
+
+	providerType := schemaResp.Provider.ValueType().(tftypes.Object)
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: dynamicValue(providerType, testObjectValue(providerType, map[string]tftypes.Value{
+			"org_id":              tftypes.NewValue(tftypes.String, "test-org"),
+			"token":               tftypes.NewValue(tftypes.String, "TEST_TOKEN"),
+			"skip_api_validation": tftypes.NewValue(tftypes.Bool, true),
+			"allow_force_delete":  tftypes.NewValue(tftypes.Bool, allowForceDelete),
+		})),
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, configureResp.Diagnostics)
+
+	valueType := schemaResp.ResourceSchemas["humanitec_value"].ValueType().(tftypes.Object)
+	configValue := tftypes.NewValue(valueType, nil)
+	proposedValue := tftypes.NewValue(valueType, nil)
+	if config != nil {
+		configValue = testObjectValue(valueType, config)
+
+		// Terraform proposes the prior value of computed attributes missing in the config
+		proposed := map[string]tftypes.Value{"id": prior["id"]}
+		for name, v := range config {
+			proposed[name] = v
+		}
+		proposedValue = testObjectValue(valueType, proposed)
+	}
+
+	resp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         "humanitec_value",
+		PriorState:       dynamicValue(valueType, testObjectValue(valueType, prior)),
+		ProposedNewState: dynamicValue(valueType, proposedValue),
+		Config:           dynamicValue(valueType, configValue),
+	})
+	assert.NoError(t, err)
+	return resp
+}
+
+func TestHumanitecDataValidateSecretValueDestroy(t *testing.T) {
+	value := func(key string, isSecret bool, description string, preventDestroySecret bool) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"app_id":                 tftypes.NewValue(tftypes.String, "app"),
+			"key":                    tftypes.NewValue(tftypes.String, key),
+			"description":            tftypes.NewValue(tftypes.String, description),
+			"is_secret":              tftypes.NewValue(tftypes.Bool, isSecret),
+			"value":                  tftypes.NewValue(tftypes.String, "value"),
+			"prevent_destroy_secret": tftypes.NewValue(tftypes.Bool, preventDestroySecret),
+		}
+	}
+	state := func(isSecret, preventDestroySecret bool) map[string]tftypes.Value {
+		s := value("KEY", isSecret, "", preventDestroySecret)
+		s["id"] = tftypes.NewValue(tftypes.String, "app/KEY")
+		return s
+	}
+
+	tests := []struct {
+		name             string
+		allowForceDelete bool
+		prior            map[string]tftypes.Value
+		config           map[string]tftypes.Value
+		expectReplace    bool
+		expectError      bool
+		expectWarning    bool
+	}{
+		{name: "destroy value", prior: state(false, true)},
+		{name: "destroy secret", prior: state(true, false), expectWarning: true},
+		{name: "destroy protected secret", prior: state(true, true), expectError: true},
+		{name: "force destroy protected secret", allowForceDelete: true, prior: state(true, true), expectWarning: true},
+		{name: "replace protected secret", prior: state(true, true), config: value("RENAMED", true, "", true), expectReplace: true, expectError: true},
+		{name: "replace secret", prior: state(true, false), config: value("RENAMED", true, "", false), expectReplace: true, expectWarning: true},
+		{name: "replace value", prior: state(false, true), config: value("RENAMED", false, "", true), expectReplace: true},
+		{name: "update protected secret", prior: state(true, true), config: value("KEY", true, "changed", true)},
+		{name: "unchanged protected secret", prior: state(true, true), config: value("KEY", true, "", true)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := planValueChange(t, tc.allowForceDelete, tc.prior, tc.config)
+
+			var errors, warnings int
+			for _, d := range resp.Diagnostics {
+				switch d.Severity {
+				case tfprotov6.DiagnosticSeverityError:
+					errors++
+				case tfprotov6.DiagnosticSeverityWarning:
+					warnings++
+				}
+			}
+			assert.Equal(t, tc.expectReplace, len(resp.RequiresReplace) > 0, "%v", resp.RequiresReplace)
+			assert.Equal(t, tc.expectError, errors > 0, "%v", resp.Diagnostics)
+			assert.Equal(t, tc.expectWarning, warnings > 0, "%v", resp.Diagnostics)
+		})
+	}
+}
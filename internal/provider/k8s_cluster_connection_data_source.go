@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/convert"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &K8sClusterConnectionDataSource{}
+
+const k8sClusterResourceType = "k8s-cluster"
+
+func NewK8sClusterConnectionDataSource() datasource.DataSource {
+	return &K8sClusterConnectionDataSource{}
+}
+
+// K8sClusterConnectionDataSource defines the data source implementation.
+type K8sClusterConnectionDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// K8sClusterConnectionDataSourceModel describes the data source data model.
+type K8sClusterConnectionDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	DefinitionID    types.String `tfsdk:"definition_id"`
+	Name            types.String `tfsdk:"name"`
+	Loadbalancer    types.String `tfsdk:"loadbalancer"`
+	ProjectID       types.String `tfsdk:"project_id"`
+	Zone            types.String `tfsdk:"zone"`
+	Region          types.String `tfsdk:"region"`
+	ActiveResources types.List   `tfsdk:"active_resources"`
+}
+
+// K8sClusterActiveResourceModel describes the connection outputs of a single active k8s-cluster resource.
+type K8sClusterActiveResourceModel struct {
+	AppID        types.String `tfsdk:"app_id"`
+	EnvID        types.String `tfsdk:"env_id"`
+	ResID        types.String `tfsdk:"res_id"`
+	DefVersionID types.String `tfsdk:"def_version_id"`
+	Status       types.String `tfsdk:"status"`
+	Name         types.String `tfsdk:"name"`
+	Loadbalancer types.String `tfsdk:"loadbalancer"`
+	ProjectID    types.String `tfsdk:"project_id"`
+	Zone         types.String `tfsdk:"zone"`
+	Region       types.String `tfsdk:"region"`
+}
+
+var k8sClusterActiveResourceAttrTypes = map[string]attr.Type{
+	"app_id":         types.StringType,
+	"env_id":         types.StringType,
+	"res_id":         types.StringType,
+	"def_version_id": types.StringType,
+	"status":         types.StringType,
+	"name":           types.StringType,
+	"loadbalancer":   types.StringType,
+	"project_id":     types.StringType,
+	"zone":           types.StringType,
+	"region":         types.StringType,
+}
+
+func (d *K8sClusterConnectionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_k8s_cluster_connection"
+}
+
+func (d *K8sClusterConnectionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Non-secret connection outputs of a `k8s-cluster` Resource Definition and of the Active Resources provisioned from it. Credentials are never exposed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"definition_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the `k8s-cluster` Resource Definition.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the cluster as configured in the Resource Definition.",
+				Computed:            true,
+			},
+			"loadbalancer": schema.StringAttribute{
+				MarkdownDescription: "The IP address or hostname of the cluster load balancer as configured in the Resource Definition.",
+				Computed:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The project of the cluster as configured in the Resource Definition, if any.",
+				Computed:            true,
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "The zone of the cluster as configured in the Resource Definition, if any.",
+				Computed:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region of the cluster as configured in the Resource Definition, if any.",
+				Computed:            true,
+			},
+			"active_resources": schema.ListAttribute{
+				MarkdownDescription: "List of Active Resources provisioned from the Resource Definition with their `app_id`, `env_id`, `res_id`, `def_version_id`, `status` and the `name`, `loadbalancer`, `project_id`, `zone` and `region` provisioning outputs.",
+				ElementType: types.ObjectType{
+					AttrTypes: k8sClusterActiveResourceAttrTypes,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *K8sClusterConnectionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *K8sClusterConnectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data K8sClusterConnectionDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defID := data.DefinitionID.ValueString()
+
+	defResp, err := d.client.GetResourceDefinitionWithResponse(ctx, d.orgId, defID, &client.GetResourceDefinitionParams{Deleted: convert.ToPtr(false)})
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to read resource definition, got error: %s", err))
+		return
+	}
+	if defResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to read resource definition, unexpected status code: %d, body: %s", defResp.StatusCode(), defResp.Body))
+		return
+	}
+	if defResp.JSON200.Type != k8sClusterResourceType {
+		resp.Diagnostics.AddError(HUM_INPUT_ERR, fmt.Sprintf("Resource definition %s is of type %s, expected %s", defID, defResp.JSON200.Type, k8sClusterResourceType))
+		return
+	}
+
+	var values map[string]interface{}
+	if defResp.JSON200.DriverInputs != nil && defResp.JSON200.DriverInputs.Values != nil {
+		values = *defResp.JSON200.DriverInputs.Values
+	}
+	data.Name = k8sClusterOutput(values, "name")
+	data.Loadbalancer = k8sClusterOutput(values, "loadbalancer")
+	data.ProjectID = k8sClusterOutput(values, "project_id")
+	data.Zone = k8sClusterOutput(values, "zone")
+	data.Region = k8sClusterOutput(values, "region")
+
+	activeResp, err := d.client.ListActiveResourceByDefinitionWithResponse(ctx, d.orgId, defID)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list active resources, got error: %s", err))
+		return
+	}
+	if activeResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list active resources, unexpected status code: %d, body: %s", activeResp.StatusCode(), activeResp.Body))
+		return
+	}
+
+	activeResources := []basetypes.ObjectValue{}
+	if activeResp.JSON200 != nil {
+		for _, res := range *activeResp.JSON200 {
+			activeResource, diags := types.ObjectValueFrom(ctx, k8sClusterActiveResourceAttrTypes, &K8sClusterActiveResourceModel{
+				AppID:        types.StringValue(res.AppId),
+				EnvID:        types.StringValue(res.EnvId),
+				ResID:        types.StringValue(res.ResId),
+				DefVersionID: types.StringValue(res.DefVersionId),
+				Status:       types.StringValue(res.Status),
+				Name:         k8sClusterOutput(res.Resource, "name"),
+				Loadbalancer: k8sClusterOutput(res.Resource, "loadbalancer"),
+				ProjectID:    k8sClusterOutput(res.Resource, "project_id"),
+				Zone:         k8sClusterOutput(res.Resource, "zone"),
+				Region:       k8sClusterOutput(res.Resource, "region"),
+			})
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			activeResources = append(activeResources, activeResource)
+		}
+	}
+
+	activeResourcesList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: k8sClusterActiveResourceAttrTypes}, activeResources)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ActiveResources = activeResourcesList
+	data.ID = types.StringValue(defID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// k8sClusterOutput returns the string output with the given key or null if it isn't set.
+func k8sClusterOutput(values map[string]interface{}, key string) types.String {
+	v, ok := valueAtPath[string](values, []string{key})
+	if !ok {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
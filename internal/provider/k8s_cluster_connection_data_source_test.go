@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccK8sClusterConnectionDataSource(t *testing.T) {
+	id := fmt.Sprintf("gke-conn-test-%d", time.Now().UnixNano())
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccK8sClusterConnectionDataSourceConfig(id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.humanitec_k8s_cluster_connection.test", "id", id),
+					resource.TestCheckResourceAttr("data.humanitec_k8s_cluster_connection.test", "name", "test-cluster"),
+					resource.TestCheckResourceAttr("data.humanitec_k8s_cluster_connection.test", "loadbalancer", "1.1.1.1"),
+					resource.TestCheckResourceAttr("data.humanitec_k8s_cluster_connection.test", "project_id", "test"),
+					resource.TestCheckResourceAttr("data.humanitec_k8s_cluster_connection.test", "zone", "europe-west3"),
+					resource.TestCheckNoResourceAttr("data.humanitec_k8s_cluster_connection.test", "region"),
+					resource.TestCheckResourceAttr("data.humanitec_k8s_cluster_connection.test", "active_resources.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccK8sClusterConnectionDataSourceConfig(id string) string {
+	return fmt.Sprintf(`
+resource "humanitec_resource_definition" "test" {
+  id          = "%s"
+  name        = "gke-conn-test"
+  type        = "k8s-cluster"
+  driver_type = "humanitec/k8s-cluster-gke"
+
+  driver_inputs = {
+    values_string = jsonencode({
+      "loadbalancer" = "1.1.1.1"
+      "name"         = "test-cluster"
+      "project_id"   = "test"
+      "zone"         = "europe-west3"
+    })
+    secrets_string = jsonencode({
+      "credentials" = {}
+    })
+  }
+}
+
+data "humanitec_k8s_cluster_connection" "test" {
+  definition_id = humanitec_resource_definition.test.id
+}
+`, id)
+}
+
+func TestK8sClusterOutput(t *testing.T) {
+	values := map[string]interface{}{
+		"name": "test-cluster",
+		"port": 443,
+	}
+
+	assert.Equal(t, types.StringValue("test-cluster"), k8sClusterOutput(values, "name"))
+	assert.Equal(t, types.StringNull(), k8sClusterOutput(values, "port"))
+	assert.Equal(t, types.StringNull(), k8sClusterOutput(values, "zone"))
+	assert.Equal(t, types.StringNull(), k8sClusterOutput(nil, "name"))
+}
@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// operationMetrics counts the API calls of a single resource operation, it's passed along in the request context.
+type operationMetrics struct {
+	calls    atomic.Int64
+	attempts atomic.Int64
+}
+
+type operationMetricsKey struct{}
+
+func operationMetricsFromContext(ctx context.Context) *operationMetrics {
+	metrics, _ := ctx.Value(operationMetricsKey{}).(*operationMetrics)
+	return metrics
+}
+
+// attemptCounter counts every attempt of a request, including retries, for the operation of the request context.
+type attemptCounter struct {
+	next http.RoundTripper
+}
+
+func (c *attemptCounter) RoundTrip(req *http.Request) (*http.Response, error) {
+	if metrics := operationMetricsFromContext(req.Context()); metrics != nil {
+		metrics.attempts.Add(1)
+	}
+	return c.next.RoundTrip(req)
+}
+
+// resourceTypeMetrics are the totals of all operations of a resource type.
+type resourceTypeMetrics struct {
+	Operations int64
+	Calls      int64
+	Retries    int64
+	Duration   time.Duration
+}
+
+// operationMetricsRecorder sums up the metrics of the operations of the provider process per resource type.
+type operationMetricsRecorder struct {
+	mu         sync.Mutex
+	operations int64
+	totals     map[string]*resourceTypeMetrics
+}
+
+func newOperationMetricsRecorder() *operationMetricsRecorder {
+	return &operationMetricsRecorder{totals: map[string]*resourceTypeMetrics{}}
+}
+
+// record adds an operation to the totals and returns the number of recorded operations and a summary of the totals.
+func (r *operationMetricsRecorder) record(typeName string, operation resourceTypeMetrics) (int64, map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	totals, ok := r.totals[typeName]
+	if !ok {
+		totals = &resourceTypeMetrics{}
+		r.totals[typeName] = totals
+	}
+	totals.Operations += operation.Operations
+	totals.Calls += operation.Calls
+	totals.Retries += operation.Retries
+	totals.Duration += operation.Duration
+	r.operations += operation.Operations
+
+	summary := make(map[string]string, len(r.totals))
+	for name, t := range r.totals {
+		summary[name] = fmt.Sprintf("%d operations, %d API calls, %d retries, %s", t.Operations, t.Calls, t.Retries, t.Duration.Round(time.Millisecond))
+	}
+
+	return r.operations, summary
+}
+
+// startOperation starts measuring a resource operation if operation_metrics is enabled. The returned function logs the
+// metrics of the operation and the totals of the provider process so far at the debug level, so the last entry of a run
+// summarizes all of it.
+func (d *HumanitecData) startOperation(ctx context.Context, typeName, operation string) (context.Context, func()) {
+	if d == nil || d.OperationMetrics == nil {
+		return ctx, func() {}
+	}
+
+	metrics := &operationMetrics{}
+	ctx = context.WithValue(ctx, operationMetricsKey{}, metrics)
+	start := time.Now()
+
+	return ctx, func() {
+		calls := metrics.calls.Load()
+		current := resourceTypeMetrics{
+			Operations: 1,
+			Calls:      calls,
+			Retries:    max(metrics.attempts.Load()-calls, 0),
+			Duration:   time.Since(start),
+		}
+		operations, totals := d.OperationMetrics.record(typeName, current)
+
+		tflog.Debug(ctx, "Humanitec API metrics", map[string]interface{}{
+			"resource_type":    typeName,
+			"operation":        operation,
+			"api_calls":        current.Calls,
+			"retries":          current.Retries,
+			"duration":         current.Duration.Round(time.Millisecond).String(),
+			"total_operations": operations,
+			"totals":           totals,
+		})
+	}
+}
+
+// withOperationMetrics wraps the resources to measure and record their operations, see measuredResource.
+func withOperationMetrics(resources []func() resource.Resource) []func() resource.Resource {
+	result := make([]func() resource.Resource, 0, len(resources))
+	for _, newResource := range resources {
+		result = append(result, func() resource.Resource {
+			return newMeasuredResource(newResource())
+		})
+	}
+	return result
+}
+
+// resourceTypeName returns the type name of r in this provider.
+func resourceTypeName(r resource.Resource) string {
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "humanitec"}, resp)
+	return resp.TypeName
+}
+
+// newMeasuredResource wraps inner in a measuredResource, which implements the same optional resource interfaces as inner
+// does. The framework checks for these interfaces, e.g. only resources implementing ResourceWithImportState can be
+// imported, so the wrapper must neither hide nor add any of them. Only ResourceWithConfigure is always implemented, as
+// measuredResource needs the provider data, all resources of the provider implement it as well.
+func newMeasuredResource(inner resource.Resource) resource.Resource {
+	measured := &measuredResource{Resource: inner, typeName: resourceTypeName(inner)}
+
+	switch inner := inner.(type) {
+	case interface {
+		resource.ResourceWithImportState
+		resource.ResourceWithModifyPlan
+	}:
+		return &struct {
+			*measuredResource
+			importStateForwarder
+			modifyPlanForwarder
+		}{measured, importStateForwarder{inner}, modifyPlanForwarder{inner}}
+	case resource.ResourceWithImportState:
+		return &struct {
+			*measuredResource
+			importStateForwarder
+		}{measured, importStateForwarder{inner}}
+	case resource.ResourceWithModifyPlan:
+		return &struct {
+			*measuredResource
+			modifyPlanForwarder
+		}{measured, modifyPlanForwarder{inner}}
+	default:
+		return measured
+	}
+}
+
+// importStateForwarder forwards ImportState to the wrapped resource.
+type importStateForwarder struct {
+	inner resource.ResourceWithImportState
+}
+
+func (f importStateForwarder) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	f.inner.ImportState(ctx, req, resp)
+}
+
+// modifyPlanForwarder forwards ModifyPlan to the wrapped resource.
+type modifyPlanForwarder struct {
+	inner resource.ResourceWithModifyPlan
+}
+
+func (f modifyPlanForwarder) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	f.inner.ModifyPlan(ctx, req, resp)
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithConfigure = &measuredResource{}
+
+// measuredResource records the metrics of the operations of a resource when operation_metrics is enabled, and the
+// requests of operations changing objects when record_mode is "plan-only". Use newMeasuredResource to keep the optional
+// interfaces of the wrapped resource.
+type measuredResource struct {
+	resource.Resource
+
+	typeName string
+	data     *HumanitecData
+}
+
+func (r *measuredResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if inner, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		inner.Configure(ctx, req, resp)
+	}
+
+	// Errors are reported by the wrapped resource
+	if data, err := configureFromProviderData(req.ProviderData); err == nil {
+		r.data = data
+	}
+}
+
+func (r *measuredResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, done := r.data.startOperation(ctx, r.typeName, "create")
+	ctx, recorded := r.data.startRecording(ctx, r.typeName, "create")
+	r.Resource.Create(ctx, req, resp)
+	recorded(&resp.Diagnostics)
+	done()
+}
+
+func (r *measuredResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, done := r.data.startOperation(ctx, r.typeName, "read")
+	r.Resource.Read(ctx, req, resp)
+	done()
+}
+
+func (r *measuredResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, done := r.data.startOperation(ctx, r.typeName, "update")
+	ctx, recorded := r.data.startRecording(ctx, r.typeName, "update")
+	r.Resource.Update(ctx, req, resp)
+	recorded(&resp.Diagnostics)
+	done()
+}
+
+func (r *measuredResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, done := r.data.startOperation(ctx, r.typeName, "delete")
+	ctx, recorded := r.data.startRecording(ctx, r.typeName, "delete")
+	r.Resource.Delete(ctx, req, resp)
+	recorded(&resp.Diagnostics)
+	done()
+}
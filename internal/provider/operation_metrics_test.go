@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+// retryOnceTransport sends every request twice, like the retrying transport of the provider does after a failed attempt.
+type retryOnceTransport struct {
+	next http.RoundTripper
+}
+
+func (t *retryOnceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	res.Body.Close()
+
+	return t.next.RoundTrip(req)
+}
+
+func TestOperationMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	recorder := newAPIUsageRecorder(&http.Client{
+		Transport: &retryOnceTransport{next: &attemptCounter{next: http.DefaultTransport}},
+	})
+	data := &HumanitecData{APIUsage: recorder, OperationMetrics: newOperationMetricsRecorder()}
+
+	call := func(ctx context.Context) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+		assert.NoError(err)
+		res, err := recorder.Do(req)
+		assert.NoError(err)
+		res.Body.Close()
+	}
+
+	var logs bytes.Buffer
+	logCtx := tflogtest.RootLogger(context.Background(), &logs)
+
+	ctx, done := data.startOperation(logCtx, "humanitec_value", "create")
+	call(ctx)
+	call(ctx)
+	done()
+
+	ctx, done = data.startOperation(logCtx, "humanitec_application", "read")
+	call(ctx)
+	done()
+
+	// Calls outside of operations aren't counted
+	call(context.Background())
+
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	assert.NoError(err)
+	if !assert.Len(entries, 2) {
+		return
+	}
+	assert.Equal("Humanitec API metrics", entries[0]["@message"])
+	assert.Equal("humanitec_value", entries[0]["resource_type"])
+	assert.Equal(float64(2), entries[0]["api_calls"])
+	assert.Equal(float64(2), entries[0]["retries"])
+	assert.Equal("humanitec_application", entries[1]["resource_type"])
+	assert.Equal("read", entries[1]["operation"])
+	assert.Equal(float64(2), entries[1]["total_operations"])
+	totals, _ := entries[1]["totals"].(map[string]interface{})
+	assert.Contains(totals["humanitec_application"], "1 operations, 1 API calls, 1 retries")
+	assert.Contains(totals["humanitec_value"], "1 operations, 2 API calls, 2 retries")
+
+	logs.Reset()
+	_, done = (&HumanitecData{}).startOperation(logCtx, "humanitec_value", "create")
+	done()
+	assert.Empty(logs.String(), "disabled by default")
+}
+
+func TestMeasuredResourceInterfaces(t *testing.T) {
+	implements := func(r resource.Resource) map[string]bool {
+		_, configure := r.(resource.ResourceWithConfigure)
+		_, configValidators := r.(resource.ResourceWithConfigValidators)
+		_, importState := r.(resource.ResourceWithImportState)
+		_, modifyPlan := r.(resource.ResourceWithModifyPlan)
+		_, moveState := r.(resource.ResourceWithMoveState)
+		_, upgradeState := r.(resource.ResourceWithUpgradeState)
+		_, validateConfig := r.(resource.ResourceWithValidateConfig)
+		return map[string]bool{
+			"ResourceWithConfigure":        configure,
+			"ResourceWithConfigValidators": configValidators,
+			"ResourceWithImportState":      importState,
+			"ResourceWithModifyPlan":       modifyPlan,
+			"ResourceWithMoveState":        moveState,
+			"ResourceWithUpgradeState":     upgradeState,
+			"ResourceWithValidateConfig":   validateConfig,
+		}
+	}
+
+	for _, newResource := range New("test")().Resources(context.Background()) {
+		wrapped := newResource()
+
+		// The wrapped resource is embedded in every variant of measuredResource
+		inner, ok := reflect.ValueOf(wrapped).Elem().FieldByName("Resource").Interface().(resource.Resource)
+		if !assert.True(t, ok, "%T isn't a measured resource", wrapped) {
+			continue
+		}
+
+		t.Run(resourceTypeName(inner), func(t *testing.T) {
+			assert.Equal(t, implements(inner), implements(wrapped))
+		})
+	}
+}
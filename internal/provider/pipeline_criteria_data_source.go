@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/hashcode"
+	"github.com/humanitec/terraform-provider-humanitec/internal/pagination"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PipelineCriteriaDataSource{}
+
+func NewPipelineCriteriaDataSource() datasource.DataSource {
+	return &PipelineCriteriaDataSource{}
+}
+
+// PipelineCriteriaDataSource defines the data source implementation.
+type PipelineCriteriaDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// PipelineCriteriaDataSourceModel describes the data source data model.
+type PipelineCriteriaDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	AppID      types.String `tfsdk:"app_id"`
+	PipelineID types.String `tfsdk:"pipeline_id"`
+	Criteria   types.List   `tfsdk:"criteria"`
+}
+
+// PipelineCriteriaDataSourceItemModel describes a single pipeline criteria.
+type PipelineCriteriaDataSourceItemModel struct {
+	ID             types.String `tfsdk:"id"`
+	PipelineID     types.String `tfsdk:"pipeline_id"`
+	PipelineName   types.String `tfsdk:"pipeline_name"`
+	Trigger        types.String `tfsdk:"trigger"`
+	AppID          types.String `tfsdk:"app_id"`
+	EnvType        types.String `tfsdk:"env_type"`
+	EnvID          types.String `tfsdk:"env_id"`
+	DeploymentType types.String `tfsdk:"deployment_type"`
+}
+
+var pipelineCriteriaAttrTypes = map[string]attr.Type{
+	"id":              types.StringType,
+	"pipeline_id":     types.StringType,
+	"pipeline_name":   types.StringType,
+	"trigger":         types.StringType,
+	"app_id":          types.StringType,
+	"env_type":        types.StringType,
+	"env_id":          types.StringType,
+	"deployment_type": types.StringType,
+}
+
+func (d *PipelineCriteriaDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline_criteria"
+}
+
+func (d *PipelineCriteriaDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Pipeline criteria defined in an Application. Can be used to check for existing criteria before creating a `humanitec_pipeline_criteria`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the Application.",
+				Required:            true,
+			},
+			"pipeline_id": schema.StringAttribute{
+				MarkdownDescription: "Only return the criteria of the Pipeline with this id.",
+				Optional:            true,
+			},
+			"criteria": schema.ListAttribute{
+				MarkdownDescription: "List of Pipeline criteria with their `id`, `pipeline_id`, `pipeline_name` and `trigger`. The `app_id`, `env_type`, `env_id` and `deployment_type` matching fields are set for `deployment_request` triggers.",
+				ElementType: types.ObjectType{
+					AttrTypes: pipelineCriteriaAttrTypes,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *PipelineCriteriaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *PipelineCriteriaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PipelineCriteriaDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pipelineCriteria, err := pagination.All(ctx, func(ctx context.Context, editor pagination.RequestEditor) ([]client.PipelineCriteria, *http.Response, error) {
+		httpResp, err := d.client.ListPipelineCriteriaInAppWithResponse(ctx, d.orgId, data.AppID.ValueString(), &client.ListPipelineCriteriaInAppParams{
+			Pipeline: data.PipelineID.ValueStringPointer(),
+		}, editor)
+		if err != nil {
+			return nil, nil, err
+		}
+		if httpResp.StatusCode() != 200 {
+			return nil, nil, fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+		}
+		if httpResp.JSON200 == nil {
+			return nil, httpResp.HTTPResponse, nil
+		}
+		return *httpResp.JSON200, httpResp.HTTPResponse, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list pipeline criteria, got error: %s", err))
+		return
+	}
+
+	criteriaIds := []string{}
+	criteria := []basetypes.ObjectValue{}
+	for _, res := range pipelineCriteria {
+		item, diags := parsePipelineCriteriaDataSourceResponse(res)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		c, diags := types.ObjectValueFrom(ctx, pipelineCriteriaAttrTypes, item)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		criteriaIds = append(criteriaIds, res.Id)
+		criteria = append(criteria, c)
+	}
+
+	criteriaList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: pipelineCriteriaAttrTypes}, criteria)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Criteria = criteriaList
+	data.ID = types.StringValue(hashcode.Strings(criteriaIds))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func parsePipelineCriteriaDataSourceResponse(res client.PipelineCriteria) (*PipelineCriteriaDataSourceItemModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	item := &PipelineCriteriaDataSourceItemModel{
+		ID:             types.StringValue(res.Id),
+		PipelineID:     types.StringValue(res.PipelineId),
+		PipelineName:   types.StringValue(res.PipelineName),
+		Trigger:        types.StringValue(res.Trigger),
+		AppID:          types.StringNull(),
+		EnvType:        types.StringNull(),
+		EnvID:          types.StringNull(),
+		DeploymentType: types.StringNull(),
+	}
+
+	if res.Trigger == "deployment_request" {
+		drc, err := res.AsPipelineDeploymentRequestCriteria()
+		if err != nil {
+			diags.AddError(HUM_API_ERR, fmt.Sprintf("Unable to parse pipeline criteria %s, got error: %s", res.Id, err))
+			return nil, diags
+		}
+		item.AppID = types.StringPointerValue(drc.AppId)
+		item.EnvType = types.StringPointerValue(drc.EnvType)
+		item.EnvID = types.StringPointerValue(drc.EnvId)
+		item.DeploymentType = types.StringPointerValue(drc.DeploymentType)
+	}
+
+	return item, diags
+}
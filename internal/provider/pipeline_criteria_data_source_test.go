@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPipelineCriteriaDataSource(t *testing.T) {
+	// avoid conflict by giving apps a unique id
+	testUid := int(time.Now().UnixMilli())
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineCriteriaDataSourceConfig(testUid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.humanitec_pipeline_criteria.test", "criteria.#", "1"),
+					resource.TestCheckResourceAttrPair("data.humanitec_pipeline_criteria.test", "criteria.0.id", "humanitec_pipeline_criteria.c1", "id"),
+					resource.TestCheckResourceAttr("data.humanitec_pipeline_criteria.test", "criteria.0.pipeline_name", "Test pipeline"),
+					resource.TestCheckResourceAttr("data.humanitec_pipeline_criteria.test", "criteria.0.trigger", "deployment_request"),
+					resource.TestCheckResourceAttr("data.humanitec_pipeline_criteria.test", "criteria.0.env_id", "development"),
+					resource.TestCheckResourceAttr("data.humanitec_pipeline_criteria.test", "criteria.0.deployment_type", "deploy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPipelineCriteriaDataSourceConfig(testUid int) string {
+	return fmt.Sprintf(`
+resource humanitec_application "app" {
+	id = "app%[1]d"
+	name = "App %[1]d"
+}
+
+resource humanitec_pipeline "pip" {
+	app_id = humanitec_application.app.id
+	definition = <<EOT
+name: Test pipeline
+on:
+  deployment_request: {}
+jobs:
+  thing:
+    steps:
+    - uses: actions/humanitec/log
+      with:
+        message: $${{ tojson(inputs) }}
+EOT
+}
+
+resource humanitec_pipeline_criteria "c1" {
+	app_id = humanitec_application.app.id
+	pipeline_id = humanitec_pipeline.pip.id
+	deployment_request = {
+		env_id = "development"
+		deployment_type = "deploy"
+	}
+}
+
+data humanitec_pipeline_criteria "test" {
+	app_id = humanitec_application.app.id
+	pipeline_id = humanitec_pipeline.pip.id
+
+	depends_on = [humanitec_pipeline_criteria.c1]
+}
+`, testUid)
+}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/hashcode"
+	"github.com/humanitec/terraform-provider-humanitec/internal/pagination"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PipelineRunsDataSource{}
+
+func NewPipelineRunsDataSource() datasource.DataSource {
+	return &PipelineRunsDataSource{}
+}
+
+// PipelineRunsDataSource defines the data source implementation.
+type PipelineRunsDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// PipelineRunsDataSourceModel describes the data source data model.
+type PipelineRunsDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	AppID         types.String `tfsdk:"app_id"`
+	PipelineID    types.String `tfsdk:"pipeline_id"`
+	Statuses      types.List   `tfsdk:"statuses"`
+	CreatedWithin types.String `tfsdk:"created_within"`
+	Runs          types.List   `tfsdk:"runs"`
+}
+
+// PipelineRunModel describes a single pipeline run.
+type PipelineRunModel struct {
+	ID            types.String `tfsdk:"id"`
+	Status        types.String `tfsdk:"status"`
+	StatusMessage types.String `tfsdk:"status_message"`
+	Trigger       types.String `tfsdk:"trigger"`
+	TriggeredBy   types.String `tfsdk:"triggered_by"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	ExecutedAt    types.String `tfsdk:"executed_at"`
+	CompletedAt   types.String `tfsdk:"completed_at"`
+}
+
+var pipelineRunAttrTypes = map[string]attr.Type{
+	"id":             types.StringType,
+	"status":         types.StringType,
+	"status_message": types.StringType,
+	"trigger":        types.StringType,
+	"triggered_by":   types.StringType,
+	"created_at":     types.StringType,
+	"executed_at":    types.StringType,
+	"completed_at":   types.StringType,
+}
+
+func (d *PipelineRunsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline_runs"
+}
+
+func (d *PipelineRunsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs of a Pipeline, newest first. Can be used to build release dashboards or checks, e.g. that no run failed in the last 24 hours.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the Application.",
+				Required:            true,
+			},
+			"pipeline_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the Pipeline.",
+				Required:            true,
+			},
+			"statuses": schema.ListAttribute{
+				MarkdownDescription: "Only return runs with one of these statuses, e.g. `failed`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"created_within": schema.StringAttribute{
+				MarkdownDescription: "Only return runs created within this duration before the data source is read, e.g. `24h`.",
+				Optional:            true,
+			},
+			"runs": schema.ListAttribute{
+				MarkdownDescription: "List of Pipeline runs with their `id`, `status`, `status_message`, `trigger`, `triggered_by` and the RFC3339 `created_at`, `executed_at` and `completed_at` timestamps.",
+				ElementType: types.ObjectType{
+					AttrTypes: pipelineRunAttrTypes,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *PipelineRunsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *PipelineRunsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PipelineRunsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var statuses []string
+	if !data.Statuses.IsNull() {
+		resp.Diagnostics.Append(data.Statuses.ElementsAs(ctx, &statuses, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var createdAfter time.Time
+	if createdWithin := data.CreatedWithin.ValueString(); createdWithin != "" {
+		within, err := time.ParseDuration(createdWithin)
+		if err != nil || within <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("created_within"), HUM_INPUT_ERR, fmt.Sprintf("%q must be a positive duration, e.g. 24h", createdWithin))
+			return
+		}
+		createdAfter = time.Now().Add(-within)
+	}
+
+	pipelineRuns, err := pagination.All(ctx, func(ctx context.Context, editor pagination.RequestEditor) ([]client.PipelineRun, *http.Response, error) {
+		httpResp, err := d.client.ListPipelineRunsWithResponse(ctx, d.orgId, data.AppID.ValueString(), data.PipelineID.ValueString(), &client.ListPipelineRunsParams{}, editor)
+		if err != nil {
+			return nil, nil, err
+		}
+		if httpResp.StatusCode() != 200 {
+			return nil, nil, fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+		}
+		if httpResp.JSON200 == nil {
+			return nil, httpResp.HTTPResponse, nil
+		}
+		return *httpResp.JSON200, httpResp.HTTPResponse, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list pipeline runs, got error: %s", err))
+		return
+	}
+
+	pipelineRuns = filterPipelineRuns(pipelineRuns, statuses, createdAfter)
+
+	runIds := []string{}
+	runs := []basetypes.ObjectValue{}
+	for _, res := range pipelineRuns {
+		run, diags := types.ObjectValueFrom(ctx, pipelineRunAttrTypes, parsePipelineRunResponse(res))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		runIds = append(runIds, res.Id)
+		runs = append(runs, run)
+	}
+
+	runList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: pipelineRunAttrTypes}, runs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Runs = runList
+	data.ID = types.StringValue(hashcode.Strings(runIds))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterPipelineRuns returns the runs with one of the statuses created after createdAfter, newest first. Empty filters match all runs.
+func filterPipelineRuns(runs []client.PipelineRun, statuses []string, createdAfter time.Time) []client.PipelineRun {
+	filtered := []client.PipelineRun{}
+	for _, run := range runs {
+		if len(statuses) > 0 && !slices.Contains(statuses, run.Status) {
+			continue
+		}
+		if !createdAfter.IsZero() && !run.CreatedAt.After(createdAfter) {
+			continue
+		}
+		filtered = append(filtered, run)
+	}
+
+	slices.SortStableFunc(filtered, func(a, b client.PipelineRun) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	return filtered
+}
+
+func parsePipelineRunResponse(res client.PipelineRun) *PipelineRunModel {
+	return &PipelineRunModel{
+		ID:            types.StringValue(res.Id),
+		Status:        types.StringValue(res.Status),
+		StatusMessage: types.StringValue(res.StatusMessage),
+		Trigger:       types.StringValue(res.Trigger),
+		TriggeredBy:   types.StringValue(res.RunAs),
+		CreatedAt:     types.StringValue(res.CreatedAt.Format(time.RFC3339)),
+		ExecutedAt:    timePointerValue(res.ExecutingAt),
+		CompletedAt:   timePointerValue(res.CompletedAt),
+	}
+}
+
+// timePointerValue formats an optional timestamp as RFC3339, nil is null.
+func timePointerValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/humanitec/humanitec-go-autogen/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccPipelineRunsDataSource(t *testing.T) {
+	// avoid conflict by giving apps a unique id
+	testUid := int(time.Now().UnixMilli())
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineRunsDataSourceConfig(testUid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.humanitec_pipeline_runs.failed", "runs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPipelineRunsDataSourceConfig(testUid int) string {
+	return fmt.Sprintf(`
+resource humanitec_application "app" {
+	id = "app%[1]d"
+	name = "App %[1]d"
+}
+
+resource humanitec_pipeline "pip" {
+	app_id = humanitec_application.app.id
+	definition = <<EOT
+name: Test pipeline
+on:
+  pipeline_call: {}
+jobs:
+  thing:
+    steps:
+    - uses: actions/humanitec/log
+      with:
+        message: hello
+EOT
+}
+
+data humanitec_pipeline_runs "failed" {
+	app_id         = humanitec_application.app.id
+	pipeline_id    = humanitec_pipeline.pip.id
+	statuses       = ["failed"]
+	created_within = "24h"
+}
+`, testUid)
+}
+
+func TestFilterPipelineRuns(t *testing.T) {
+	now := time.Now()
+	runs := []client.PipelineRun{
+		{Id: "old-failed", Status: "failed", CreatedAt: now.Add(-48 * time.Hour)},
+		{Id: "failed", Status: "failed", CreatedAt: now.Add(-2 * time.Hour)},
+		{Id: "succeeded", Status: "succeeded", CreatedAt: now.Add(-1 * time.Hour)},
+	}
+
+	ids := func(runs []client.PipelineRun) []string {
+		ids := []string{}
+		for _, run := range runs {
+			ids = append(ids, run.Id)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []string{"succeeded", "failed", "old-failed"}, ids(filterPipelineRuns(runs, nil, time.Time{})))
+	assert.Equal(t, []string{"failed", "old-failed"}, ids(filterPipelineRuns(runs, []string{"failed"}, time.Time{})))
+	assert.Equal(t, []string{"failed"}, ids(filterPipelineRuns(runs, []string{"failed"}, now.Add(-24*time.Hour))))
+	assert.Equal(t, []string{}, ids(filterPipelineRuns(runs, []string{"cancelled"}, time.Time{})))
+}
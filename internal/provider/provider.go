@@ -3,12 +3,17 @@ package provider
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,6 +21,7 @@ import (
 	"github.com/justinrixx/retryhttp"
 
 	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
 )
 
 // Ensure HumanitecProvider satisfies various provider interfaces.
@@ -31,13 +37,26 @@ type HumanitecProvider struct {
 
 // HumanitecProviderModel describes the provider data model.
 type HumanitecProviderModel struct {
-	APIPrefix types.String `tfsdk:"api_prefix"`
-	Host      types.String `tfsdk:"host"`
-	OrgID     types.String `tfsdk:"org_id"`
-	Token     types.String `tfsdk:"token"`
-	Config    types.String `tfsdk:"config"`
+	APIPrefix  types.String `tfsdk:"api_prefix"`
+	Host       types.String `tfsdk:"host"`
+	OrgID      types.String `tfsdk:"org_id"`
+	Token      types.String `tfsdk:"token"`
+	Config     types.String `tfsdk:"config"`
+	HTTPSProxy types.String `tfsdk:"https_proxy"`
+
+	DefaultAppPrefix   types.String `tfsdk:"default_app_prefix"`
+	IDNamingConvention types.String `tfsdk:"id_naming_convention"`
 
 	DisableSSLCertificateVerification types.Bool `tfsdk:"disable_ssl_certificate_verification"`
+	ValidateReferences                types.Bool `tfsdk:"validate_references"`
+	WarnPlaintextSecrets              types.Bool `tfsdk:"warn_plaintext_secrets"`
+	AllowForceDelete                  types.Bool `tfsdk:"allow_force_delete"`
+	DetectMovedApplications           types.Bool `tfsdk:"detect_moved_applications"`
+	SkipAPIValidation                 types.Bool `tfsdk:"skip_api_validation"`
+	ConfirmDestructiveViaAPI          types.Bool `tfsdk:"confirm_destructive_via_api"`
+	OperationMetrics                  types.Bool `tfsdk:"operation_metrics"`
+
+	RecordMode types.String `tfsdk:"record_mode"`
 }
 
 const (
@@ -58,7 +77,7 @@ func (p *HumanitecProvider) Schema(ctx context.Context, req provider.SchemaReque
 
 		Attributes: map[string]schema.Attribute{
 			"api_prefix": schema.StringAttribute{
-				MarkdownDescription: "Humanitec API prefix (or using the `HUMANITEC_API_PREFIX` environment variable)",
+				MarkdownDescription: "Humanitec API prefix (or using the `HUMANITEC_API_PREFIX` environment variable), it may include a path when the API is accessed through a gateway, e.g. `https://gateway.example.com/humanitec/`.",
 				Optional:            true,
 			},
 			"host": schema.StringAttribute{
@@ -75,12 +94,56 @@ func (p *HumanitecProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"https_proxy": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy used for requests to the Humanitec API (or using the `HUMANITEC_HTTPS_PROXY` environment variable), `http`, `https` and `socks5` proxies are supported. Takes precedence over the `HTTPS_PROXY` environment variable, which also applies to other providers.",
+				Optional:            true,
+			},
 			"disable_ssl_certificate_verification": schema.BoolAttribute{
 				MarkdownDescription: "Disables SSL certificate verification",
 				Optional:            true,
 			},
+			"validate_references": schema.BoolAttribute{
+				MarkdownDescription: "Verify during plan that the applications referenced by `app_id` of app-scoped resources exist. Only enable if the applications are not created in the same configuration.",
+				Optional:            true,
+			},
+			"warn_plaintext_secrets": schema.BoolAttribute{
+				MarkdownDescription: "Warn during plan when a resource definition uses `driver_inputs.secrets_string` while the primary secret store of the organization is an external one, `driver_inputs.secret_refs` should be used instead. Defaults to `true`.",
+				Optional:            true,
+			},
+			"allow_force_delete": schema.BoolAttribute{
+				MarkdownDescription: "Allow resources to set `force_delete = true`, which deletes them even if this affects existing Active Resources. Plans enabling `force_delete` fail unless this is set. Defaults to `false`.",
+				Optional:            true,
+			},
+			"detect_moved_applications": schema.BoolAttribute{
+				MarkdownDescription: "When an application managed by `humanitec_application` no longer exists in the configured organization, look it up in the other organizations accessible with the token and fail with an error instead of planning its recreation. Defaults to `false`.",
+				Optional:            true,
+			},
+			"confirm_destructive_via_api": schema.BoolAttribute{
+				MarkdownDescription: "Verify during plan that the token has the organization role required to delete the resource definitions and matching criteria the plan destroys or replaces, failing the plan instead of a partially applied change. Defaults to `false`.",
+				Optional:            true,
+			},
+			"skip_api_validation": schema.BoolAttribute{
+				MarkdownDescription: "Skip the plan time checks that require access to the Humanitec API, like `validate_references`, `warn_plaintext_secrets` and `confirm_destructive_via_api`, and only warn about a missing token or organization. Allows `terraform plan -refresh=false` to run without network access, e.g. to lint configurations in CI. Data sources still require API access. Defaults to `false`.",
+				Optional:            true,
+			},
+			"operation_metrics": schema.BoolAttribute{
+				MarkdownDescription: "Log the number of API calls, retries and duration of every resource operation at the `DEBUG` level, together with the totals per resource type of the run so far. The last entry summarizes the whole run, which helps to tune `-parallelism` and to find slow resources in large organizations. Defaults to `false`.",
+				Optional:            true,
+			},
+			"record_mode": schema.StringAttribute{
+				MarkdownDescription: "Set to `plan-only` to record the requests of resource operations that would create, update or delete objects in the API instead of sending them. The request bodies, e.g. the driver inputs of resource definitions, are added as a warning to the operation, which then fails, so review tooling can inspect the exact payloads before a privileged apply. Secrets are redacted, reads are still sent to the API. Only the first request of an operation is recorded, as later ones depend on its response.",
+				Optional:            true,
+			},
+			"default_app_prefix": schema.StringAttribute{
+				MarkdownDescription: "Prefix the ids of applications created with `humanitec_application` have to start with, e.g. `team-a-`. Plans creating applications without it fail.",
+				Optional:            true,
+			},
+			"id_naming_convention": schema.StringAttribute{
+				MarkdownDescription: "Regular expression the ids of created applications, environments and resource definitions have to match, e.g. `^[a-z]+-(dev|staging|prod)$`. Plans creating resources with other ids fail, existing resources aren't affected.",
+				Optional:            true,
+			},
 			"config": schema.StringAttribute{
-				MarkdownDescription: "Location of Humanitec configuration",
+				MarkdownDescription: "Location of the Humanitec CLI (`humctl`) configuration file (or using the `HUMCTL_CONFIG` environment variable), defaults to `~/.humctl`. The `token`, `org` and `apiPrefix` of the file are used if not configured otherwise.",
 				Optional:            true,
 			},
 		},
@@ -120,10 +183,6 @@ func (p *HumanitecProvider) Configure(ctx context.Context, req provider.Configur
 		apiPrefix = os.Getenv("HUMANITEC_API_PREFIX")
 	}
 
-	if apiPrefix == "" {
-		apiPrefix = humanitec.DefaultAPIHost
-	}
-
 	if os.Getenv("HUMANITEC_ORG") != "" {
 		orgID = os.Getenv("HUMANITEC_ORG")
 	}
@@ -146,6 +205,16 @@ func (p *HumanitecProvider) Configure(ctx context.Context, req provider.Configur
 		apiPrefix = data.APIPrefix.ValueString()
 	}
 
+	if apiPrefix == "" {
+		apiPrefix = humanitec.DefaultAPIHost
+	}
+
+	apiPrefix, err := normalizeAPIPrefix(apiPrefix)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid API prefix configuration", err.Error())
+		return
+	}
+
 	if !data.OrgID.IsNull() {
 		orgID = data.OrgID.ValueString()
 	}
@@ -154,28 +223,63 @@ func (p *HumanitecProvider) Configure(ctx context.Context, req provider.Configur
 		token = data.Token.ValueString()
 	}
 
+	skipAPIValidation := data.SkipAPIValidation.ValueBool()
+
+	// Without API validation the credentials are only needed once the API is called
+	addMissingConfig := resp.Diagnostics.AddError
+	if skipAPIValidation {
+		addMissingConfig = resp.Diagnostics.AddWarning
+	}
+
 	if token == "" {
-		resp.Diagnostics.AddError(
+		addMissingConfig(
 			"Missing API Token Configuration",
 			"While configuring the provider, the API token was not found in "+
-				"the HUMANITEC_TOKEN environment variable or provider "+
-				"configuration block token attribute.",
+				"the HUMANITEC_TOKEN environment variable, provider "+
+				"configuration block token attribute or humctl config file. "+
+				"Run `humctl login` or configure a token explicitly.",
 		)
 		// Not returning early allows the logic to collect all errors.
 	}
 
 	if orgID == "" {
-		resp.Diagnostics.AddError(
+		addMissingConfig(
 			"Missing API Org ID Configuration",
-			"While configuring the provider, the API token was not found in "+
-				"the HUMANITEC_ORG environment variable or provider "+
-				"configuration block org_id attribute.",
+			"While configuring the provider, the API org ID was not found in "+
+				"the HUMANITEC_ORG environment variable, provider "+
+				"configuration block org_id attribute or humctl config file.",
 		)
 		// Not returning early allows the logic to collect all errors.
 	}
 
+	var idNamingConvention *regexp.Regexp
+	if pattern := data.IDNamingConvention.ValueString(); pattern != "" {
+		idNamingConvention, err = regexp.Compile(pattern)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("id_naming_convention"), "Invalid naming convention configuration", fmt.Sprintf("%q is not a valid regular expression: %s", pattern, err))
+			return
+		}
+	}
+
+	recordMode := data.RecordMode.ValueString()
+	if recordMode != "" && recordMode != recordModePlanOnly {
+		resp.Diagnostics.AddAttributeError(path.Root("record_mode"), "Invalid record mode configuration", fmt.Sprintf("Unsupported record mode %q, only %q is supported", recordMode, recordModePlanOnly))
+		return
+	}
+
+	httpsProxy := os.Getenv("HUMANITEC_HTTPS_PROXY")
+	if !data.HTTPSProxy.IsNull() {
+		httpsProxy = data.HTTPSProxy.ValueString()
+	}
+
+	proxy, err := proxyFunc(httpsProxy)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("https_proxy"), "Invalid proxy configuration", err.Error())
+		return
+	}
+
 	baseTransport := &http.Transport{
-		Proxy: http.ProxyFromEnvironment,
+		Proxy: proxy,
 		DialContext: (&net.Dialer{
 			Timeout:   30 * time.Second,
 			KeepAlive: 30 * time.Second,
@@ -193,9 +297,14 @@ func (p *HumanitecProvider) Configure(ctx context.Context, req provider.Configur
 
 	doer := &http.Client{
 		Timeout:   time.Minute,
-		Transport: retryhttp.New(retryhttp.WithTransport(baseTransport)),
+		Transport: retryhttp.New(retryhttp.WithTransport(&attemptCounter{next: baseTransport})),
+	}
+	usage := newAPIUsageRecorder(doer)
+	var clientDoer client.HttpRequestDoer = usage
+	if recordMode == recordModePlanOnly {
+		clientDoer = &requestRecorder{doer: usage}
 	}
-	client, err := NewHumanitecClient(apiPrefix, token, p.version, doer)
+	client, err := NewHumanitecClient(apiPrefix, token, p.version, clientDoer)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create Humanitec client", err.Error())
 	}
@@ -205,16 +314,79 @@ func (p *HumanitecProvider) Configure(ctx context.Context, req provider.Configur
 	}
 
 	sourcedata := &HumanitecData{
-		Client: client,
-		OrgID:  orgID,
+		Client:             client,
+		OrgID:              orgID,
+		APIUsage:           usage,
+		ValidateReferences: data.ValidateReferences.ValueBool() && !skipAPIValidation,
+		// Warnings are enabled unless explicitly disabled
+		WarnPlaintextSecrets:    (data.WarnPlaintextSecrets.IsNull() || data.WarnPlaintextSecrets.ValueBool()) && !skipAPIValidation,
+		AllowForceDelete:        data.AllowForceDelete.ValueBool(),
+		DetectMovedApplications: data.DetectMovedApplications.ValueBool(),
+		// Requires API access during plan
+		ConfirmDestructiveViaAPI: data.ConfirmDestructiveViaAPI.ValueBool() && !skipAPIValidation,
+		DefaultAppPrefix:         data.DefaultAppPrefix.ValueString(),
+		IDNamingConvention:       idNamingConvention,
+		RecordMode:               recordMode,
+	}
+	if data.OperationMetrics.ValueBool() {
+		sourcedata.OperationMetrics = newOperationMetricsRecorder()
 	}
 
 	resp.DataSourceData = sourcedata
 	resp.ResourceData = sourcedata
 }
 
+// normalizeAPIPrefix validates the API prefix, which may include a path e.g. when the API is accessed through a gateway,
+// and ends it with a single slash so that the API paths are appended to the path instead of replacing it.
+func normalizeAPIPrefix(apiPrefix string) (string, error) {
+	prefixURL, err := url.Parse(apiPrefix)
+	if err != nil {
+		return "", fmt.Errorf("API prefix %q is not a valid URL: %w", apiPrefix, err)
+	}
+
+	switch prefixURL.Scheme {
+	case "http", "https":
+	default:
+		return "", fmt.Errorf("API prefix %q must use the http or https scheme", apiPrefix)
+	}
+	if prefixURL.Host == "" {
+		return "", fmt.Errorf("API prefix %q has no host", apiPrefix)
+	}
+	if prefixURL.RawQuery != "" || prefixURL.Fragment != "" {
+		return "", fmt.Errorf("API prefix %q can't contain a query or fragment", apiPrefix)
+	}
+
+	prefixURL.Path = strings.TrimRight(prefixURL.Path, "/") + "/"
+	prefixURL.RawPath = ""
+
+	return prefixURL.String(), nil
+}
+
+// proxyFunc returns the proxy selection of the HTTP transport, requests use the given proxy URL if set or the one of the environment otherwise.
+func proxyFunc(proxy string) (func(*http.Request) (*url.URL, error), error) {
+	if proxy == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+
+	proxyURL, err := url.Parse(proxy)
+	if err != nil {
+		return nil, fmt.Errorf("proxy %q is not a valid URL: %w", proxy, err)
+	}
+
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("proxy %q must use the http, https or socks5 scheme", proxy)
+	}
+	if proxyURL.Host == "" {
+		return nil, fmt.Errorf("proxy %q has no host", proxy)
+	}
+
+	return http.ProxyURL(proxyURL), nil
+}
+
 func (p *HumanitecProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	return withOperationMetrics([]func() resource.Resource{
 		NewResourceAccountResource,
 		NewResourceAgent,
 		NewResourceApplication,
@@ -231,21 +403,43 @@ func (p *HumanitecProvider) Resources(ctx context.Context) []func() resource.Res
 		NewResourceRegistry,
 		NewResourceResourceClass,
 		NewResourceResourceDriver,
+		NewResourceResourceGraphAction,
 		NewResourceRule,
 		NewResourceSecretStore,
 		NewResourceServiceUserToken,
 		NewResourceValue,
+		NewResourceValueSetVersionRestore,
 		NewResourceUser,
 		NewResourceWebhook,
 		NewResourceWorkloadProfileChartVersion,
 		NewResourceWorkloadProfile,
-	}
+	})
 }
 
 func (p *HumanitecProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewActiveResourcesDataSource,
+		NewAPIUsageDataSource,
+		NewDeploymentErrorsDataSource,
+		NewDeploymentSetDataSource,
+		NewDriverSchemaDataSource,
+		NewEnvironmentTypesDataSource,
+		NewExpiredEnvironmentsDataSource,
+		NewK8sClusterConnectionDataSource,
+		NewPipelineCriteriaDataSource,
+		NewPipelineRunsDataSource,
+		NewRegistriesDataSource,
+		NewResourceDefinitionCriteriaDataSource,
+		NewResourceDefinitionManifestDataSource,
+		NewResourceDefinitionsDataSource,
+		NewResourceGraphDataSource,
+		NewRulesDataSource,
+		NewSecretStoresDataSource,
 		NewSourceIPRangesDataSource,
+		NewUserDataSource,
 		NewUsersDataSource,
+		NewValueSetVersionsDataSource,
+		NewWorkloadProfileChartVersionsDataSource,
 	}
 }
 
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// testAccUpgradeExternalProviders returns the released provider the upgrade
+// tests start from. HUMANITEC_UPGRADE_FROM_VERSION pins a version constraint,
+// the latest release is used otherwise.
+func testAccUpgradeExternalProviders() map[string]resource.ExternalProvider {
+	return map[string]resource.ExternalProvider{
+		"humanitec": {
+			Source:            "humanitec/humanitec",
+			VersionConstraint: os.Getenv("HUMANITEC_UPGRADE_FROM_VERSION"),
+		},
+	}
+}
+
+// testAccUpgradeSteps applies config with the released provider and then plans
+// it with the provider under test, which must not report any changes.
+func testAccUpgradeSteps(config string) []resource.TestStep {
+	return []resource.TestStep{
+		{
+			ExternalProviders: testAccUpgradeExternalProviders(),
+			Config:            config,
+		},
+		{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Config:                   config,
+			PlanOnly:                 true,
+		},
+	}
+}
+
+func TestAccProviderUpgrade(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	pipelineDefinition := `
+name: Hello from terraform
+on:
+  pipeline_call:
+jobs:
+  approve:
+    steps:
+    - name: approve
+      uses: actions/humanitec/approve
+      with:
+        environment: development
+        message: Test message
+`
+
+	tests := []struct {
+		name   string
+		config string
+	}{
+		{
+			name:   "resource_definition",
+			config: testAccResourceDefinitionS3Resource(fmt.Sprintf("s3-upgrade-test-%d", suffix), "us-east-1"),
+		},
+		{
+			name:   "value",
+			config: testAccResourceVALUETestAccResourceValue(fmt.Sprintf("val-upgrade-test-%d", suffix), "VAL_1", "Example value"),
+		},
+		{
+			name:   "secretstore",
+			config: testAccSecretStoreVault(fmt.Sprintf("vault-upgrade-test-%d", suffix), "vault-url", "vault-token", false),
+		},
+		{
+			name:   "pipeline",
+			config: testAccResourcePipeline(fmt.Sprintf("pipeline-upgrade-test-%d", suffix), pipelineDefinition),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testAccTest(t, resource.TestCase{
+				PreCheck: func() { testAccPreCheck(t) },
+				Steps:    testAccUpgradeSteps(tt.config),
+			})
+		})
+	}
+}
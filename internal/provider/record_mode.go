@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/humanitec/humanitec-go-autogen/client"
+)
+
+// recordModePlanOnly records the requests of resource operations changing objects in the API instead of sending them.
+const recordModePlanOnly = "plan-only"
+
+const recordedSensitiveValue = "(sensitive value)"
+
+// recordedRequest is an API request recorded instead of sent.
+type recordedRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Body   any    `json:"body,omitempty"`
+}
+
+// operationRecording collects the recorded requests of a single resource operation, it's passed along in the request context.
+type operationRecording struct {
+	mu       sync.Mutex
+	requests []recordedRequest
+}
+
+type operationRecordingKey struct{}
+
+func operationRecordingFromContext(ctx context.Context) *operationRecording {
+	recording, _ := ctx.Value(operationRecordingKey{}).(*operationRecording)
+	return recording
+}
+
+// requestRecorder wraps the HTTP client of the provider and records the requests of resource operations changing
+// objects in the API instead of sending them. Reads and requests outside of resource operations, like data sources
+// and plan time checks, are sent as usual.
+type requestRecorder struct {
+	doer client.HttpRequestDoer
+}
+
+func (r *requestRecorder) Do(req *http.Request) (*http.Response, error) {
+	recording := operationRecordingFromContext(req.Context())
+	if recording == nil || req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return r.doer.Do(req)
+	}
+
+	recorded := recordedRequest{Method: req.Method, Path: req.URL.RequestURI()}
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		recorded.Body = recordedBody(body)
+	}
+
+	recording.mu.Lock()
+	recording.requests = append(recording.requests, recorded)
+	recording.mu.Unlock()
+
+	return nil, fmt.Errorf("%s %s was recorded instead of sent, record_mode is %q", req.Method, req.URL.Path, recordModePlanOnly)
+}
+
+// recordedBody decodes a JSON request body with secrets redacted, other bodies are kept as string.
+func recordedBody(body []byte) any {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+	return redactRecordedValue(v)
+}
+
+// recordedSecretKeys are the keys whose values are redacted completely, e.g. the secret driver inputs of resource
+// definitions, the secret references of shared values, registry credentials and the authentication of secret stores.
+var recordedSecretKeys = []string{"secrets", "secret_ref", "creds", "auth", "password", "token", "secret"}
+
+// redactRecordedValue replaces everything below a secret key and the value of secret shared values, as the recorded
+// requests end up in the output of Terraform.
+func redactRecordedValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if slices.Contains(recordedSecretKeys, strings.ToLower(key)) {
+				v[key] = redactAllRecordedValues(child)
+			} else {
+				v[key] = redactRecordedValue(child)
+			}
+		}
+		if isSecret, _ := v["is_secret"].(bool); isSecret {
+			if _, ok := v["value"]; ok {
+				v["value"] = recordedSensitiveValue
+			}
+		}
+	case []any:
+		for i, child := range v {
+			v[i] = redactRecordedValue(child)
+		}
+	}
+	return v
+}
+
+func redactAllRecordedValues(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, child := range v {
+			v[key] = redactAllRecordedValues(child)
+		}
+		return v
+	case []any:
+		for i, child := range v {
+			v[i] = redactAllRecordedValues(child)
+		}
+		return v
+	case nil:
+		return nil
+	default:
+		return recordedSensitiveValue
+	}
+}
+
+// startRecording starts recording the requests of a resource operation if record_mode is "plan-only". The returned
+// function adds a warning with the recorded requests of the operation, the operation itself fails with the error of
+// the first request that wasn't sent.
+func (d *HumanitecData) startRecording(ctx context.Context, typeName, operation string) (context.Context, func(diags *diag.Diagnostics)) {
+	if d == nil || d.RecordMode != recordModePlanOnly {
+		return ctx, func(*diag.Diagnostics) {}
+	}
+
+	recording := &operationRecording{}
+	ctx = context.WithValue(ctx, operationRecordingKey{}, recording)
+
+	return ctx, func(diags *diag.Diagnostics) {
+		recording.mu.Lock()
+		defer recording.mu.Unlock()
+
+		if len(recording.requests) == 0 {
+			return
+		}
+
+		requests, err := json.MarshalIndent(recording.requests, "", "  ")
+		if err != nil {
+			diags.AddError(HUM_PROVIDER_ERR, fmt.Sprintf("Unable to serialize the recorded requests, got error: %s", err))
+			return
+		}
+
+		// Terraform groups warnings with the same summary, the number of operations keeps them apart
+		diags.AddWarning(
+			fmt.Sprintf("Humanitec API requests recorded, operation %d", d.recordedOperations.Add(1)),
+			fmt.Sprintf("%s %s: record_mode is %q, the following requests were recorded instead of sent:\n\n%s", typeName, operation, recordModePlanOnly, requests),
+		)
+	}
+}
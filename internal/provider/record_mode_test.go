@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordMode(t *testing.T) {
+	assert := assert.New(t)
+
+	var sent []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Method+" "+r.URL.Path)
+	}))
+	defer srv.Close()
+
+	data := &HumanitecData{RecordMode: recordModePlanOnly}
+	recorder := &requestRecorder{doer: http.DefaultClient}
+
+	do := func(ctx context.Context, method, path, body string) error {
+		req, err := http.NewRequestWithContext(ctx, method, srv.URL+path, strings.NewReader(body))
+		assert.NoError(err)
+		res, err := recorder.Do(req)
+		if err == nil {
+			res.Body.Close()
+		}
+		return err
+	}
+
+	var diags diag.Diagnostics
+	ctx, recorded := data.startRecording(context.Background(), "humanitec_resource_definition", "create")
+	assert.NoError(do(ctx, http.MethodGet, "/orgs/test/resources/defs/example", ""))
+	err := do(ctx, http.MethodPost, "/orgs/test/resources/defs", `{"id":"example","driver_inputs":{"values":{"host":"example.com"},"secrets":{"password":"secret","nested":{"key":"secret"}}}}`)
+	assert.ErrorContains(err, "POST /orgs/test/resources/defs was recorded instead of sent")
+	err = do(ctx, http.MethodPost, "/orgs/test/apps/app/values", `{"key":"API_KEY","is_secret":true,"secret_ref":{"store":"vault","ref":"path/to/key","value":"secret"}}`)
+	assert.ErrorContains(err, "POST /orgs/test/apps/app/values was recorded instead of sent")
+	err = do(ctx, http.MethodPost, "/orgs/test/registries", `{"id":"registry","registry":"registry.example.com","creds":{"username":"user","password":"secret"}}`)
+	assert.ErrorContains(err, "POST /orgs/test/registries was recorded instead of sent")
+	err = do(ctx, http.MethodPost, "/orgs/test/secretstores", `{"id":"store","awssm":{"region":"eu-central-1","auth":{"access_key_id":"key","secret_access_key":"secret"}}}`)
+	assert.ErrorContains(err, "POST /orgs/test/secretstores was recorded instead of sent")
+	recorded(&diags)
+
+	// Requests outside of resource operations are sent
+	assert.NoError(do(context.Background(), http.MethodPost, "/orgs/test/resources/graph", "[]"))
+
+	assert.Equal([]string{"GET /orgs/test/resources/defs/example", "POST /orgs/test/resources/graph"}, sent)
+
+	if assert.Equal(1, diags.WarningsCount()) {
+		assert.Equal("Humanitec API requests recorded, operation 1", diags[0].Summary())
+		detail := diags[0].Detail()
+		assert.Contains(detail, "humanitec_resource_definition create")
+		assert.Contains(detail, `"path": "/orgs/test/resources/defs"`)
+		assert.Contains(detail, `"host": "example.com"`)
+		assert.Contains(detail, `"password": "(sensitive value)"`)
+		assert.Contains(detail, `"key": "(sensitive value)"`)
+		assert.Contains(detail, `"path": "/orgs/test/apps/app/values"`)
+		assert.Contains(detail, `"path": "/orgs/test/registries"`)
+		assert.Contains(detail, `"registry": "registry.example.com"`)
+		assert.Contains(detail, `"path": "/orgs/test/secretstores"`)
+		assert.Contains(detail, `"region": "eu-central-1"`)
+		assert.NotContains(detail, `"secret"`)
+		assert.NotContains(detail, `"path/to/key"`)
+		assert.NotContains(detail, `"user"`)
+	}
+
+	diags = nil
+	_, recorded = (&HumanitecData{}).startRecording(context.Background(), "humanitec_value", "create")
+	recorded(&diags)
+	assert.Empty(diags, "disabled by default")
+}
+
+func TestRecordedBody(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(map[string]any{"key": "API_KEY", "is_secret": true, "value": recordedSensitiveValue}, recordedBody([]byte(`{"key":"API_KEY","is_secret":true,"value":"secret"}`)))
+	assert.Equal(map[string]any{"key": "HOST", "is_secret": false, "value": "example.com"}, recordedBody([]byte(`{"key":"HOST","is_secret":false,"value":"example.com"}`)))
+
+	// humanitec_value with a secret reference
+	assert.Equal(map[string]any{
+		"key":        "API_KEY",
+		"is_secret":  true,
+		"secret_ref": map[string]any{"store": recordedSensitiveValue, "ref": recordedSensitiveValue, "value": recordedSensitiveValue},
+	}, recordedBody([]byte(`{"key":"API_KEY","is_secret":true,"secret_ref":{"store":"vault","ref":"path/to/key","value":"secret"}}`)))
+
+	// humanitec_registry credentials
+	assert.Equal(map[string]any{
+		"id":       "registry",
+		"registry": "registry.example.com",
+		"creds":    map[string]any{"username": recordedSensitiveValue, "password": recordedSensitiveValue},
+	}, recordedBody([]byte(`{"id":"registry","registry":"registry.example.com","creds":{"username":"user","password":"secret"}}`)))
+
+	// humanitec_secretstore authentication
+	assert.Equal(map[string]any{
+		"id":    "store",
+		"awssm": map[string]any{"region": "eu-central-1", "auth": map[string]any{"access_key_id": recordedSensitiveValue, "secret_access_key": recordedSensitiveValue}},
+	}, recordedBody([]byte(`{"id":"store","awssm":{"region":"eu-central-1","auth":{"access_key_id":"key","secret_access_key":"secret"}}}`)))
+
+	// Secret keys outside of the known subtrees
+	assert.Equal(map[string]any{"url": "https://example.com", "token": recordedSensitiveValue, "Password": recordedSensitiveValue}, recordedBody([]byte(`{"url":"https://example.com","token":"secret","Password":"secret"}`)))
+
+	assert.Equal("not json", recordedBody([]byte("not json")))
+	assert.Nil(recordedBody([]byte(" ")))
+}
+
+func TestProviderConfigureInvalidRecordMode(t *testing.T) {
+	assert := assert.New(t)
+	clearProviderEnv(t)
+
+	_, diags := configureTestProvider(t, map[string]tftypes.Value{
+		"org_id":      tftypes.NewValue(tftypes.String, "test-org"),
+		"token":       tftypes.NewValue(tftypes.String, "test-token"),
+		"record_mode": tftypes.NewValue(tftypes.String, "everything"),
+	})
+	assert.Equal([]string{"Invalid record mode configuration"}, diagnosticSummaries(diags.Errors()))
+}
+
+// deletingTestResource sends a request to url when it's deleted.
+type deletingTestResource struct {
+	resource.Resource
+
+	url string
+}
+
+func (r *deletingTestResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test"
+}
+
+func (r *deletingTestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+}
+
+func (r *deletingTestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodDelete, r.url, nil)
+	if err == nil {
+		_, err = (&requestRecorder{doer: http.DefaultClient}).Do(httpReq)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, err.Error())
+	}
+}
+
+func TestMeasuredResourceRecordMode(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer srv.Close()
+
+	r := newMeasuredResource(&deletingTestResource{url: srv.URL + "/orgs/test/resources/defs/example"})
+	_, ok := r.(resource.ResourceWithImportState)
+	assert.True(ok)
+
+	r.(resource.ResourceWithConfigure).Configure(context.Background(), resource.ConfigureRequest{ProviderData: &HumanitecData{RecordMode: recordModePlanOnly}}, &resource.ConfigureResponse{})
+
+	resp := &resource.DeleteResponse{}
+	r.Delete(context.Background(), resource.DeleteRequest{}, resp)
+
+	assert.Equal(1, resp.Diagnostics.ErrorsCount())
+	if assert.Equal(1, resp.Diagnostics.WarningsCount()) {
+		assert.Contains(resp.Diagnostics.Warnings()[0].Detail(), "humanitec_test delete")
+		assert.Contains(resp.Diagnostics.Warnings()[0].Detail(), `"path": "/orgs/test/resources/defs/example"`)
+	}
+}
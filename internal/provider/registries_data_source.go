@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/humanitec/humanitec-go-autogen"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/hashcode"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RegistriesDataSource{}
+
+func NewRegistriesDataSource() datasource.DataSource {
+	return &RegistriesDataSource{}
+}
+
+// RegistriesDataSource defines the data source implementation.
+type RegistriesDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// RegistriesDataSourceModel describes the data source data model.
+type RegistriesDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Registries types.List   `tfsdk:"registries"`
+}
+
+// RegistryDataSourceModel describes a single registry without its credentials.
+type RegistryDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Registry types.String `tfsdk:"registry"`
+	Type     types.String `tfsdk:"type"`
+	EnableCI types.Bool   `tfsdk:"enable_ci"`
+}
+
+var registryAttrTypes = map[string]attr.Type{
+	"id":        types.StringType,
+	"registry":  types.StringType,
+	"type":      types.StringType,
+	"enable_ci": types.BoolType,
+}
+
+func (d *RegistriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_registries"
+}
+
+func (d *RegistriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Container registries of the organization. Credentials are never exposed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"registries": schema.ListAttribute{
+				MarkdownDescription: "List of registries with their `id`, `registry` name, `type` and `enable_ci` flag.",
+				ElementType: types.ObjectType{
+					AttrTypes: registryAttrTypes,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *RegistriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *RegistriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RegistriesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := d.client.GetOrgsOrgIdRegistriesWithResponse(ctx, d.orgId)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list registries, got error: %s", err))
+		return
+	}
+	if httpResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list registries, unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	registryIds := []string{}
+	registries := []basetypes.ObjectValue{}
+	if httpResp.JSON200 != nil {
+		for _, res := range *httpResp.JSON200 {
+			registry, diags := types.ObjectValueFrom(ctx, registryAttrTypes, &RegistryDataSourceModel{
+				ID:       types.StringValue(res.Id),
+				Registry: types.StringValue(res.Registry),
+				Type:     types.StringValue(res.Type),
+				EnableCI: types.BoolValue(res.EnableCi),
+			})
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			registryIds = append(registryIds, res.Id)
+			registries = append(registries, registry)
+		}
+	}
+
+	registriesList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: registryAttrTypes}, registries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Registries = registriesList
+	data.ID = types.StringValue(hashcode.Strings(registryIds))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
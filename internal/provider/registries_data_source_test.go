@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccRegistriesDataSource(t *testing.T) {
+	id := fmt.Sprintf("test-registry-%d", time.Now().UnixNano())
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccRegistriesDataSourceConfig(id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.humanitec_registries.test", "registries.0.id"),
+					resource.TestCheckOutput("registry", "registry.example.com"),
+					resource.TestCheckOutput("type", "secret_ref"),
+					resource.TestCheckOutput("enable_ci", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRegistriesDataSourceConfig(id string) string {
+	return fmt.Sprintf(`
+resource "humanitec_registry" "test" {
+	id        = "%s"
+	registry  = "registry.example.com"
+	type      = "secret_ref"
+	enable_ci = true
+	secrets = {
+		"cluster-a" = {
+			namespace = "example-namespace"
+			secret    = "path/to/secret"
+		}
+	}
+}
+
+data "humanitec_registries" "test" {
+	depends_on = [humanitec_registry.test]
+}
+
+locals {
+	registry = one([for r in data.humanitec_registries.test.registries : r if r.id == humanitec_registry.test.id])
+}
+
+output "registry" {
+	value = local.registry.registry
+}
+
+output "type" {
+	value = local.registry.type
+}
+
+output "enable_ci" {
+	value = local.registry.enable_ci
+}
+`, id)
+}
@@ -17,7 +17,7 @@ func TestAccResourceAccountResource(t *testing.T) {
 	id := fmt.Sprintf("aws-test-%d", time.Now().UnixNano())
 	role := fmt.Sprintf("arn:aws:iam::0000000:role/test-role-%d", time.Now().UnixNano())
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -56,19 +56,15 @@ func TestAccResourceAccountResource_DeletedManually(t *testing.T) {
 
 	orgID := os.Getenv("HUMANITEC_ORG")
 	token := os.Getenv("HUMANITEC_TOKEN")
-	apiHost := os.Getenv("HUMANITEC_HOST")
-	if apiHost == "" {
-		apiHost = humanitec.DefaultAPIHost
-	}
 
 	var client *humanitec.Client
 	var err error
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
 
-			client, err = NewHumanitecClient(apiHost, token, "test", nil)
+			client, err = NewHumanitecClient(testAccAPIPrefix(), token, "test", nil)
 			assert.NoError(err)
 		},
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
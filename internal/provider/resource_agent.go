@@ -2,11 +2,17 @@ package provider
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
 	"crypto/sha256"
+	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"maps"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +25,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/humanitec/humanitec-go-autogen"
 	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/convert"
+	"github.com/humanitec/terraform-provider-humanitec/internal/pagination"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -45,6 +54,8 @@ type AgentModel struct {
 	ID          types.String `tfsdk:"id"`
 	Description types.String `tfsdk:"description"`
 	PublicKeys  []KeyModel   `tfsdk:"public_keys"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (*Agent) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -74,32 +85,36 @@ func (*Agent) Schema(ctx context.Context, req resource.SchemaRequest, resp *reso
 					Attributes: map[string]schema.Attribute{
 						"key": schema.StringAttribute{
 							Required:    true,
-							Description: "A pcks8 RSA public keys PEM encoded (as the ones produced by openssl), whose module length is greater or equal than 4096 bits.",
+							Description: "A pcks8 RSA public keys PEM encoded (as the ones produced by openssl), whose module length is greater or equal than 4096 bits. ECDSA and Ed25519 keys are not supported by the Agent.",
+							Validators: []validator.String{
+								agentPublicKeyValidator{},
+							},
 						},
 					},
 				},
-				MarkdownDescription: "A non-empty list of pcks8 RSA public keys PEM encoded (as the ones produced by openssl), whose module length is greater or equal than 4096 bits.",
+				MarkdownDescription: "A non-empty list of pcks8 RSA public keys PEM encoded (as the ones produced by openssl), whose module length is greater or equal than 4096 bits. ECDSA and Ed25519 keys are not supported by the Agent.",
 				Required:            true,
 				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 func (a *Agent) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
 		return
 	}
 
-	resdata, ok := req.ProviderData.(*HumanitecData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
 		return
 	}
 
@@ -109,11 +124,7 @@ func (a *Agent) Configure(ctx context.Context, req resource.ConfigureRequest, re
 
 func (a *AgentModel) updateFromContent(res *client.Agent, keys *[]client.Key) {
 	a.ID = types.StringValue(res.Id)
-	if res.Description == nil {
-		a.Description = types.StringValue("")
-	} else {
-		a.Description = types.StringValue(*res.Description)
-	}
+	a.Description = convert.StringOrEmpty(res.Description)
 
 	a.PublicKeys = []KeyModel{}
 	for _, key := range *keys {
@@ -130,6 +141,11 @@ func (a *AgentModel) getKeysMap() map[string]string {
 	return modelKeysMap
 }
 
+// hasSameKeys reports whether both models contain the same set of keys.
+func (a *AgentModel) hasSameKeys(other *AgentModel) bool {
+	return maps.Equal(a.getKeysMap(), other.getKeysMap())
+}
+
 func fromKeyListToMap(keys []client.Key) map[string]string {
 	var keyMap = make(map[string]string)
 	for _, key := range keys {
@@ -146,6 +162,12 @@ func (a *Agent) Create(ctx context.Context, req resource.CreateRequest, resp *re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := data.ID.ValueString()
 	description := data.Description.ValueString()
 	var keys []client.Key
@@ -199,30 +221,43 @@ func (a *Agent) Read(ctx context.Context, req resource.ReadRequest, resp *resour
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := data.ID.ValueString()
 
 	// read agent metadata
-	clientResp, err := a.client.ListAgentsWithResponse(ctx, a.orgId, nil)
+	agents, err := pagination.All(ctx, func(ctx context.Context, editor pagination.RequestEditor) ([]client.Agent, *http.Response, error) {
+		clientResp, err := a.client.ListAgentsWithResponse(ctx, a.orgId, nil, editor)
+		if err != nil {
+			return nil, nil, err
+		}
+		if clientResp.StatusCode() != http.StatusOK {
+			return nil, nil, fmt.Errorf("unexpected status code: %d, body: %s", clientResp.StatusCode(), clientResp.Body)
+		}
+		if clientResp.JSON200 == nil {
+			return nil, clientResp.HTTPResponse, nil
+		}
+		return *clientResp.JSON200, clientResp.HTTPResponse, nil
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list agents, got error: %s", err))
 		return
 	}
 	var agent *client.Agent
-	switch clientResp.StatusCode() {
-	case http.StatusOK:
-		for _, registeredAgent := range *clientResp.JSON200 {
-			if registeredAgent.Id == id {
-				agent = &registeredAgent
-				break
-			}
+	for _, registeredAgent := range agents {
+		if registeredAgent.Id == id {
+			agent = &registeredAgent
+			break
 		}
-		if agent == nil {
-			resp.Diagnostics.AddWarning("Agent not found", fmt.Sprintf("The agent (%s) was deleted outside Terraform", data.ID.ValueString()))
-			resp.State.RemoveResource(ctx)
-			return
-		}
-	default:
-		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Received unexpected status code when reading agent list: %d, body: %s", clientResp.StatusCode(), clientResp.Body))
+	}
+	if agent == nil {
+		resp.Diagnostics.AddWarning("Agent not found", fmt.Sprintf("The agent (%s) was deleted outside Terraform", data.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -245,6 +280,12 @@ func (a *Agent) Update(ctx context.Context, req resource.UpdateRequest, resp *re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 
 	// update agent description
@@ -268,47 +309,20 @@ func (a *Agent) Update(ctx context.Context, req resource.UpdateRequest, resp *re
 		return
 	}
 
-	registeredKeys, diags := a.getKeysForAnAgent(ctx, id)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	var registeredKeysMap = fromKeyListToMap(*registeredKeys)
-	var modelKeysMap = data.getKeysMap()
-
-	var keysToAdd []string
-	var keysToRemove []string
 	var keys []client.Key
-	for fingerprint := range registeredKeysMap {
-		if _, ok := modelKeysMap[fingerprint]; !ok {
-			keysToRemove = append(keysToRemove, fingerprint)
-		}
-	}
-
-	for fingerprint, key := range modelKeysMap {
-		if _, ok := registeredKeysMap[fingerprint]; ok {
+	if data.hasSameKeys(state) {
+		// Only the description changed, there are no keys to reconcile
+		for fingerprint, key := range data.getKeysMap() {
 			keys = append(keys, client.Key{Fingerprint: fingerprint, PublicKey: key})
-		} else {
-			keysToAdd = append(keysToAdd, key)
 		}
-	}
-
-	for _, fingerprint := range keysToRemove {
-		diags := a.removeKeyFromAnAgent(ctx, id, fingerprint)
+	} else {
+		var diags diag.Diagnostics
+		keys, diags = a.reconcileKeys(ctx, id, data)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
-
-	for _, key := range keysToAdd {
-		registeredKey, diags := a.addKeyToAgent(ctx, id, key)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		keys = append(keys, *registeredKey)
-	}
 	data.updateFromContent(agent, &keys)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -322,6 +336,12 @@ func (a *Agent) Delete(ctx context.Context, req resource.DeleteRequest, resp *re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := data.ID.ValueString()
 
 	clientResp, err := a.client.DeleteAgentWithResponse(ctx, a.orgId, id)
@@ -346,6 +366,55 @@ func (a *Agent) ImportState(ctx context.Context, req resource.ImportStateRequest
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// reconcileKeys registers the keys of the model missing in the agent and removes the ones not in the model anymore.
+func (a *Agent) reconcileKeys(ctx context.Context, id string, data *AgentModel) ([]client.Key, diag.Diagnostics) {
+	totalDiags := diag.Diagnostics{}
+
+	registeredKeys, diags := a.getKeysForAnAgent(ctx, id)
+	totalDiags.Append(diags...)
+	if totalDiags.HasError() {
+		return nil, totalDiags
+	}
+	var registeredKeysMap = fromKeyListToMap(*registeredKeys)
+	var modelKeysMap = data.getKeysMap()
+
+	var keysToAdd []string
+	var keysToRemove []string
+	var keys []client.Key
+	for fingerprint := range registeredKeysMap {
+		if _, ok := modelKeysMap[fingerprint]; !ok {
+			keysToRemove = append(keysToRemove, fingerprint)
+		}
+	}
+
+	for fingerprint, key := range modelKeysMap {
+		if _, ok := registeredKeysMap[fingerprint]; ok {
+			keys = append(keys, client.Key{Fingerprint: fingerprint, PublicKey: key})
+		} else {
+			keysToAdd = append(keysToAdd, key)
+		}
+	}
+
+	for _, fingerprint := range keysToRemove {
+		diags := a.removeKeyFromAnAgent(ctx, id, fingerprint)
+		totalDiags.Append(diags...)
+		if totalDiags.HasError() {
+			return nil, totalDiags
+		}
+	}
+
+	for _, key := range keysToAdd {
+		registeredKey, diags := a.addKeyToAgent(ctx, id, key)
+		totalDiags.Append(diags...)
+		if totalDiags.HasError() {
+			return nil, totalDiags
+		}
+		keys = append(keys, *registeredKey)
+	}
+
+	return keys, totalDiags
+}
+
 func (a *Agent) addKeyToAgent(ctx context.Context, agentId, key string) (*client.Key, diag.Diagnostics) {
 	totalDiags := diag.Diagnostics{}
 	clientResp, err := a.client.CreateKeyWithResponse(ctx, a.orgId, agentId, client.KeyCreateBody{PublicKey: key})
@@ -410,7 +479,63 @@ func (a *Agent) getKeysForAnAgent(ctx context.Context, agentId string) (*[]clien
 }
 
 func getFingerprintByKey(key string) string {
-	pem, _ := pem.Decode([]byte(key))
-	sha256sum := sha256.Sum256(pem.Bytes)
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return ""
+	}
+	sha256sum := sha256.Sum256(block.Bytes)
 	return fmt.Sprintf("%x", sha256sum)
 }
+
+const minAgentKeyBits = 4096
+
+// checkAgentPublicKey ensures key is a PEM encoded RSA public key the Agent API accepts, other key types like ECDSA or Ed25519 are rejected by the API.
+func checkAgentPublicKey(key string) error {
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return fmt.Errorf("key is not PEM encoded")
+	}
+	if block.Type != "PUBLIC KEY" {
+		return fmt.Errorf("PEM block type must be \"PUBLIC KEY\", got %q", block.Type)
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("key is not a pkcs8 public key: %w", err)
+	}
+
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		if bits := pub.N.BitLen(); bits < minAgentKeyBits {
+			return fmt.Errorf("RSA key has %d bits, at least %d are required", bits, minAgentKeyBits)
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		return fmt.Errorf("ECDSA keys are not supported by the Humanitec Agent, use an RSA key with at least %d bits", minAgentKeyBits)
+	case ed25519.PublicKey:
+		return fmt.Errorf("Ed25519 keys are not supported by the Humanitec Agent, use an RSA key with at least %d bits", minAgentKeyBits)
+	default:
+		return fmt.Errorf("%T keys are not supported by the Humanitec Agent, use an RSA key with at least %d bits", pub, minAgentKeyBits)
+	}
+}
+
+// agentPublicKeyValidator ensures a string is a public key accepted by the Agent API.
+type agentPublicKeyValidator struct{}
+
+func (v agentPublicKeyValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v agentPublicKeyValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value must be a PEM encoded pkcs8 RSA public key with at least %d bits", minAgentKeyBits)
+}
+
+func (v agentPublicKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkAgentPublicKey(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, HUM_INPUT_ERR, fmt.Sprintf("Invalid agent public key: %s", err))
+	}
+}
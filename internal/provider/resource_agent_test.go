@@ -2,15 +2,25 @@ package provider
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/humanitec/humanitec-go-autogen"
@@ -24,7 +34,7 @@ func TestAccAgent(t *testing.T) {
 	publicKeyTwo := getPublicKey(t)
 	publicKeyThree := getPublicKey(t)
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -89,21 +99,17 @@ func TestAccResourceAgent_DeletedManually(t *testing.T) {
 
 	orgID := os.Getenv("HUMANITEC_ORG")
 	token := os.Getenv("HUMANITEC_TOKEN")
-	apiHost := os.Getenv("HUMANITEC_HOST")
-	if apiHost == "" {
-		apiHost = humanitec.DefaultAPIHost
-	}
 
 	var client *humanitec.Client
 	var err error
 	publicKeyOne := getPublicKey(t)
 	publicKeyTwo := getPublicKey(t)
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
 
-			client, err = NewHumanitecClient(apiHost, token, "test", nil)
+			client, err = NewHumanitecClient(testAccAPIPrefix(), token, "test", nil)
 			assert.NoError(err)
 		},
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -132,6 +138,95 @@ func TestAccResourceAgent_DeletedManually(t *testing.T) {
 	})
 }
 
+func TestAgentUpdateDescriptionOnly(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "test-agent", "description": "new description", "created_at": "2024-01-01T00:00:00Z", "created_by": "test-user"}`)
+	}))
+	defer srv.Close()
+
+	humClient, err := NewHumanitecClient(srv.URL, "TEST_TOKEN", "test", &http.Client{})
+	assert.NoError(err)
+
+	agent := &Agent{client: humClient, orgId: "test-org"}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	agent.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	assert.False(schemaResp.Diagnostics.HasError())
+
+	publicKey := getPublicKey(t)
+	timeoutsType := schemaResp.Schema.Attributes["timeouts"].GetType().(timeouts.Type)
+	newModel := func(description string) *AgentModel {
+		return &AgentModel{
+			ID:          types.StringValue("test-agent"),
+			Description: types.StringValue(description),
+			PublicKeys:  []KeyModel{{Key: types.StringValue(publicKey)}},
+			Timeouts:    timeouts.Value{Object: types.ObjectNull(timeoutsType.AttrTypes)},
+		}
+	}
+
+	emptyValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: emptyValue}
+	assert.False(state.Set(ctx, newModel("old description")).HasError())
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: emptyValue}
+	assert.False(plan.Set(ctx, newModel("new description")).HasError())
+
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: emptyValue}}
+	agent.Update(ctx, fwresource.UpdateRequest{Plan: plan, State: state}, resp)
+	assert.False(resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	assert.Equal([]string{"PATCH /orgs/test-org/agents/test-agent"}, requests)
+
+	var updated *AgentModel
+	assert.False(resp.State.Get(ctx, &updated).HasError())
+	assert.Equal("new description", updated.Description.ValueString())
+	assert.Equal([]KeyModel{{Key: types.StringValue(publicKey)}}, updated.PublicKeys)
+}
+
+func TestCheckAgentPublicKey(t *testing.T) {
+	encode := func(pub any) string {
+		derBytes, err := x509.MarshalPKIXPublicKey(pub)
+		assert.NoError(t, err)
+		return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: derBytes}))
+	}
+
+	smallRSAKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	ed25519Key, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name string
+		key  string
+		err  string
+	}{
+		{name: "rsa 4096", key: getPublicKey(t)},
+		{name: "rsa 2048", key: encode(&smallRSAKey.PublicKey), err: "RSA key has 2048 bits, at least 4096 are required"},
+		{name: "ecdsa", key: encode(&ecdsaKey.PublicKey), err: "ECDSA keys are not supported by the Humanitec Agent, use an RSA key with at least 4096 bits"},
+		{name: "ed25519", key: encode(ed25519Key), err: "Ed25519 keys are not supported by the Humanitec Agent, use an RSA key with at least 4096 bits"},
+		{name: "not pem", key: "ssh-rsa AAAA", err: "key is not PEM encoded"},
+		{name: "wrong block type", key: string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: []byte("x")})), err: `PEM block type must be "PUBLIC KEY", got "RSA PRIVATE KEY"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkAgentPublicKey(tt.key)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
 func testAccCreateAgent(id, description string, publicKey, otherPublicKey string) string {
 	return fmt.Sprintf(`
 	resource "humanitec_agent" "agent_test" {
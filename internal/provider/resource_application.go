@@ -22,6 +22,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &ResourceApplication{}
 var _ resource.ResourceWithImportState = &ResourceApplication{}
+var _ resource.ResourceWithModifyPlan = &ResourceApplication{}
 
 var defaultApplicationReadTimeout = 2 * time.Minute
 var defaultApplicationDeleteTimeout = 2 * time.Minute
@@ -34,6 +35,7 @@ func NewResourceApplication() resource.Resource {
 type ResourceApplication struct {
 	client *humanitec.Client
 	orgId  string
+	data   *HumanitecData
 }
 
 // ApplicationEnvironmentModel describes the app env data model.
@@ -106,24 +108,24 @@ func (r *ResourceApplication) Schema(ctx context.Context, req resource.SchemaReq
 }
 
 func (r *ResourceApplication) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
 		return
 	}
 
-	resdata, ok := req.ProviderData.(*HumanitecData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
 		return
 	}
 
 	r.client = resdata.Client
 	r.orgId = resdata.OrgID
+	r.data = resdata
+}
+
+func (r *ResourceApplication) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.data.validateIDConvention(ctx, req, resp, "application")
 }
 
 func parseApplicationResponse(res *client.ApplicationResponse, data *ApplicationModel) {
@@ -217,6 +219,19 @@ func (r *ResourceApplication) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	if httpResp.StatusCode() == 404 {
+		movedOrgID, err := r.data.findMovedApplication(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to look up application in other organizations, got error: %s", err))
+			return
+		}
+		if movedOrgID != "" {
+			resp.Diagnostics.AddError(
+				"Application moved to another organization",
+				fmt.Sprintf("The app (%s) doesn't exist in organization %q but exists in organization %q. Update the provider org_id or remove the application from the state instead of recreating it.", data.ID.ValueString(), r.orgId, movedOrgID),
+			)
+			return
+		}
+
 		resp.Diagnostics.AddWarning("Application not found", fmt.Sprintf("The app (%s) was deleted outside Terraform", data.ID.ValueString()))
 		resp.State.RemoveResource(ctx)
 		return
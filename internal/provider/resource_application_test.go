@@ -16,7 +16,7 @@ import (
 func TestAccResourceApplication(t *testing.T) {
 	id := fmt.Sprintf("test-%d", time.Now().UnixNano())
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -46,19 +46,15 @@ func TestAccResourceApplicationDeletedOutManually(t *testing.T) {
 
 	orgID := os.Getenv("HUMANITEC_ORG")
 	token := os.Getenv("HUMANITEC_TOKEN")
-	apiHost := os.Getenv("HUMANITEC_HOST")
-	if apiHost == "" {
-		apiHost = humanitec.DefaultAPIHost
-	}
 
 	var client *humanitec.Client
 	var err error
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
 
-			client, err = NewHumanitecClient(apiHost, token, "test", nil)
+			client, err = NewHumanitecClient(testAccAPIPrefix(), token, "test", nil)
 			assert.NoError(err)
 		},
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -102,7 +98,7 @@ resource "humanitec_application" "app_test" {
 func TestAccResourceApplicationWithInitialEnv(t *testing.T) {
 	id := fmt.Sprintf("test-%d", time.Now().UnixNano())
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -90,19 +90,14 @@ func (r *ResourceApplicationUser) Schema(ctx context.Context, req resource.Schem
 }
 
 func (r *ResourceApplicationUser) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
 		return
 	}
 
-	resdata, ok := req.ProviderData.(*HumanitecData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
 		return
 	}
 
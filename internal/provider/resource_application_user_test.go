@@ -17,7 +17,7 @@ func TestAccResourceApplicationUser(t *testing.T) {
 	id := fmt.Sprintf("app-user-test-%d", time.Now().UnixNano())
 	testUserID := "1b305f15-f18f-4357-8311-01f88ed99d1b"
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -58,19 +58,15 @@ func TestAccResourceApplicationUserDeletedManually(t *testing.T) {
 
 	orgID := os.Getenv("HUMANITEC_ORG")
 	token := os.Getenv("HUMANITEC_TOKEN")
-	apiHost := os.Getenv("HUMANITEC_HOST")
-	if apiHost == "" {
-		apiHost = humanitec.DefaultAPIHost
-	}
 
 	var client *humanitec.Client
 	var err error
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
 
-			client, err = NewHumanitecClient(apiHost, token, "test", nil)
+			client, err = NewHumanitecClient(testAccAPIPrefix(), token, "test", nil)
 			assert.NoError(err)
 		},
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
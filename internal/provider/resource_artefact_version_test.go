@@ -11,7 +11,7 @@ import (
 func TestAccResourceArtifactVersion(t *testing.T) {
 	name := fmt.Sprintf("registry.humanitec.io/my-org/my-service-%d", time.Now().UnixNano())
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -47,7 +47,7 @@ resource "humanitec_artefact_version" "container_image" {
 func TestAccResourceArtifactVersionWithOptional(t *testing.T) {
 	name := fmt.Sprintf("registry.humanitec.io/my-org/my-service-%d", time.Now().UnixNano())
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/hashcode"
+	"github.com/humanitec/terraform-provider-humanitec/internal/pagination"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ResourceDefinitionCriteriaDataSource{}
+
+const defaultResourceClass = "default"
+
+func NewResourceDefinitionCriteriaDataSource() datasource.DataSource {
+	return &ResourceDefinitionCriteriaDataSource{}
+}
+
+// ResourceDefinitionCriteriaDataSource defines the data source implementation.
+type ResourceDefinitionCriteriaDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// ResourceDefinitionCriteriaDataSourceModel describes the data source data model.
+type ResourceDefinitionCriteriaDataSourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Type                 types.String `tfsdk:"type"`
+	AppID                types.String `tfsdk:"app_id"`
+	EnvID                types.String `tfsdk:"env_id"`
+	EnvType              types.String `tfsdk:"env_type"`
+	ResID                types.String `tfsdk:"res_id"`
+	Class                types.String `tfsdk:"class"`
+	ResourceDefinitionID types.String `tfsdk:"resource_definition_id"`
+	CriteriaID           types.String `tfsdk:"criteria_id"`
+}
+
+// matchingContext is the context a resource is requested in during a deployment.
+type matchingContext struct {
+	AppID   string
+	EnvID   string
+	EnvType string
+	ResID   string
+	Class   string
+}
+
+// definitionMatch is a resource definition criteria matching a context.
+type definitionMatch struct {
+	DefID      string
+	CriteriaID string
+	Rank       int
+}
+
+func (d *ResourceDefinitionCriteriaDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_definition_criteria"
+}
+
+func (d *ResourceDefinitionCriteriaDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up the Resource Definition and its matching criteria that a resource of the given type would be provisioned from in a context, e.g. to validate matching rules in CI before deploying. The matching is evaluated in the provider: criteria match if all their set fields equal the context, the criteria with the most matching fields wins, ties are broken in the order `res_id`, `env_id`, `env_type`, `app_id` and `class`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The Resource Type.",
+				Required:            true,
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Application the resource is requested in.",
+				Required:            true,
+			},
+			"env_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Environment the resource is requested in.",
+				Required:            true,
+			},
+			"env_type": schema.StringAttribute{
+				MarkdownDescription: "The Environment Type of the Environment.",
+				Required:            true,
+			},
+			"res_id": schema.StringAttribute{
+				MarkdownDescription: "The Resource ID, e.g. `modules.my-module.externals.my-db` or `shared.my-db`.",
+				Optional:            true,
+			},
+			"class": schema.StringAttribute{
+				MarkdownDescription: "The Resource Class, `default` if not set.",
+				Optional:            true,
+			},
+			"resource_definition_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the matching Resource Definition.",
+				Computed:            true,
+			},
+			"criteria_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the matching criteria of the Resource Definition.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ResourceDefinitionCriteriaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *ResourceDefinitionCriteriaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ResourceDefinitionCriteriaDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resType := data.Type.ValueString()
+	defs, err := pagination.All(ctx, func(ctx context.Context, editor pagination.RequestEditor) ([]client.ResourceDefinitionResponse, *http.Response, error) {
+		httpResp, err := d.client.ListResourceDefinitionsWithResponse(ctx, d.orgId, &client.ListResourceDefinitionsParams{ResType: &resType}, editor)
+		if err != nil {
+			return nil, nil, err
+		}
+		if httpResp.StatusCode() != 200 {
+			return nil, nil, fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+		}
+		if httpResp.JSON200 == nil {
+			return nil, httpResp.HTTPResponse, nil
+		}
+		return *httpResp.JSON200, httpResp.HTTPResponse, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list resource definitions, got error: %s", err))
+		return
+	}
+
+	class := data.Class.ValueString()
+	if class == "" {
+		class = defaultResourceClass
+	}
+	matchCtx := matchingContext{
+		AppID:   data.AppID.ValueString(),
+		EnvID:   data.EnvID.ValueString(),
+		EnvType: data.EnvType.ValueString(),
+		ResID:   data.ResID.ValueString(),
+		Class:   class,
+	}
+
+	match, ok := matchResourceDefinition(defs, resType, matchCtx)
+	if !ok {
+		resp.Diagnostics.AddError(HUM_INPUT_ERR, fmt.Sprintf("No resource definition of type %s matches app %q, env %q, env type %q, res id %q and class %q", resType, matchCtx.AppID, matchCtx.EnvID, matchCtx.EnvType, matchCtx.ResID, matchCtx.Class))
+		return
+	}
+
+	data.ResourceDefinitionID = types.StringValue(match.DefID)
+	data.CriteriaID = types.StringValue(match.CriteriaID)
+	data.ID = types.StringValue(hashcode.Strings([]string{resType, matchCtx.AppID, matchCtx.EnvID, matchCtx.EnvType, matchCtx.ResID, matchCtx.Class}))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// matchResourceDefinition returns the most specific criteria of the resource definitions of the type matching the context.
+func matchResourceDefinition(defs []client.ResourceDefinitionResponse, resType string, matchCtx matchingContext) (definitionMatch, bool) {
+	var matches []definitionMatch
+	for _, def := range defs {
+		if def.Type != resType || def.Criteria == nil {
+			continue
+		}
+		for _, c := range *def.Criteria {
+			if rank, ok := criteriaMatchRank(c, matchCtx); ok {
+				matches = append(matches, definitionMatch{DefID: def.Id, CriteriaID: c.Id, Rank: rank})
+			}
+		}
+	}
+	if len(matches) == 0 {
+		return definitionMatch{}, false
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Rank != matches[j].Rank {
+			return matches[i].Rank > matches[j].Rank
+		}
+		return matches[i].DefID < matches[j].DefID
+	})
+
+	return matches[0], true
+}
+
+// criteriaMatchRank reports whether the criteria matches the context and ranks it by the number of matching fields,
+// breaking ties in the order res_id, env_id, env_type, app_id and class.
+func criteriaMatchRank(c client.MatchingCriteriaResponse, matchCtx matchingContext) (int, bool) {
+	class := c.Class
+	if class == "" {
+		class = defaultResourceClass
+	}
+	if class != matchCtx.Class {
+		return 0, false
+	}
+
+	fields := []struct {
+		criteria *string
+		value    string
+	}{
+		{c.ResId, matchCtx.ResID},
+		{c.EnvId, matchCtx.EnvID},
+		{c.EnvType, matchCtx.EnvType},
+		{c.AppId, matchCtx.AppID},
+	}
+
+	count, precedence := 0, 0
+	for _, f := range fields {
+		precedence <<= 1
+		if f.criteria == nil || *f.criteria == "" {
+			continue
+		}
+		if *f.criteria != f.value {
+			return 0, false
+		}
+		count++
+		precedence |= 1
+	}
+
+	precedence <<= 1
+	if class != defaultResourceClass {
+		count++
+		precedence |= 1
+	}
+
+	// The count outranks the precedence bits of the fields and the class
+	return count<<(len(fields)+1) | precedence, true
+}
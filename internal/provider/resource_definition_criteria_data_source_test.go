@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/humanitec/humanitec-go-autogen/client"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/convert"
+)
+
+func TestAccResourceDefinitionCriteriaDataSource(t *testing.T) {
+	id := fmt.Sprintf("match-test-%d", time.Now().UnixNano())
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDefinitionCriteriaDataSourceConfig(id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.humanitec_resource_definition_criteria.test", "resource_definition_id", id),
+					resource.TestCheckResourceAttrPair("data.humanitec_resource_definition_criteria.test", "criteria_id", "humanitec_resource_definition_criteria.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDefinitionCriteriaDataSourceConfig(id string) string {
+	return testAccResourceDefinitionS3Resource(id, "us-east-1") + fmt.Sprintf(`
+resource "humanitec_resource_definition_criteria" "test" {
+  resource_definition_id = humanitec_resource_definition.s3_test.id
+  app_id                 = "%[1]s"
+  res_id                 = "shared.%[1]s"
+}
+
+data "humanitec_resource_definition_criteria" "test" {
+  type     = "s3"
+  app_id   = "%[1]s"
+  env_id   = "development"
+  env_type = "development"
+  res_id   = "shared.%[1]s"
+
+  depends_on = [humanitec_resource_definition_criteria.test]
+}
+`, id)
+}
+
+func TestMatchResourceDefinition(t *testing.T) {
+	defs := []client.ResourceDefinitionResponse{
+		{
+			Id:   "postgres-default",
+			Type: "postgres",
+			Criteria: &[]client.MatchingCriteriaResponse{
+				{Id: "c-default", Class: "default"},
+			},
+		},
+		{
+			Id:   "postgres-app",
+			Type: "postgres",
+			Criteria: &[]client.MatchingCriteriaResponse{
+				{Id: "c-app", AppId: convert.ToPtr("my-app"), Class: "default"},
+				{Id: "c-app-prod", AppId: convert.ToPtr("my-app"), EnvType: convert.ToPtr("production"), Class: "default"},
+			},
+		},
+		{
+			Id:   "postgres-env",
+			Type: "postgres",
+			Criteria: &[]client.MatchingCriteriaResponse{
+				{Id: "c-env", EnvId: convert.ToPtr("development"), Class: "default"},
+			},
+		},
+		{
+			Id:   "postgres-ha",
+			Type: "postgres",
+			Criteria: &[]client.MatchingCriteriaResponse{
+				{Id: "c-ha", Class: "ha"},
+			},
+		},
+		{
+			Id:   "redis-app",
+			Type: "redis",
+			Criteria: &[]client.MatchingCriteriaResponse{
+				{Id: "c-redis", AppId: convert.ToPtr("my-app"), EnvId: convert.ToPtr("development"), Class: "default"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		matchCtx   matchingContext
+		found      bool
+		defID      string
+		criteriaID string
+	}{
+		{
+			name:       "fallback",
+			matchCtx:   matchingContext{AppID: "other-app", EnvID: "staging", EnvType: "staging", Class: "default"},
+			found:      true,
+			defID:      "postgres-default",
+			criteriaID: "c-default",
+		},
+		{
+			name:       "more fields win",
+			matchCtx:   matchingContext{AppID: "my-app", EnvID: "production", EnvType: "production", Class: "default"},
+			found:      true,
+			defID:      "postgres-app",
+			criteriaID: "c-app-prod",
+		},
+		{
+			name:       "env_id outranks app_id",
+			matchCtx:   matchingContext{AppID: "my-app", EnvID: "development", EnvType: "development", Class: "default"},
+			found:      true,
+			defID:      "postgres-env",
+			criteriaID: "c-env",
+		},
+		{
+			name:       "class",
+			matchCtx:   matchingContext{AppID: "my-app", EnvID: "development", EnvType: "development", Class: "ha"},
+			found:      true,
+			defID:      "postgres-ha",
+			criteriaID: "c-ha",
+		},
+		{
+			name:     "no match",
+			matchCtx: matchingContext{AppID: "my-app", EnvID: "development", EnvType: "development", Class: "other"},
+			found:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			match, found := matchResourceDefinition(defs, "postgres", tc.matchCtx)
+			assert.Equal(tc.found, found)
+			assert.Equal(tc.defID, match.DefID)
+			assert.Equal(tc.criteriaID, match.CriteriaID)
+		})
+	}
+}
@@ -19,14 +19,20 @@ import (
 
 	"github.com/humanitec/humanitec-go-autogen"
 	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/convert"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &ResourceDefinitionCriteriaResource{}
 var _ resource.ResourceWithImportState = &ResourceDefinitionCriteriaResource{}
+var _ resource.ResourceWithModifyPlan = &ResourceDefinitionCriteriaResource{}
 
 var defaultResourceDefinitionCriteriaDeleteTimeout = 10 * time.Minute
 
+// resourceDefinitionCriteriaReplaceAttributes are the attributes replacing the Resource Definition Criteria when changed.
+var resourceDefinitionCriteriaReplaceAttributes = path.Paths{path.Root("resource_definition_id"), path.Root("app_id"), path.Root("class"), path.Root("env_id"), path.Root("env_type"), path.Root("res_id")}
+
 func NewResourceDefinitionCriteriaResource() resource.Resource {
 	return &ResourceDefinitionCriteriaResource{}
 }
@@ -53,6 +59,8 @@ type ResourceDefinitionCriteriaResourceModel struct {
 	EnvType              types.String `tfsdk:"env_type"`
 	ResID                types.String `tfsdk:"res_id"`
 	Class                types.String `tfsdk:"class"`
+	SpecificityScore     types.Int64  `tfsdk:"specificity_score"`
+	ActiveResourcesCount types.Int64  `tfsdk:"active_resources_count"`
 
 	ForceDelete types.Bool     `tfsdk:"force_delete"`
 	Timeouts    timeouts.Value `tfsdk:"timeouts"`
@@ -115,6 +123,14 @@ func (r *ResourceDefinitionCriteriaResource) Schema(ctx context.Context, req res
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"specificity_score": schema.Int64Attribute{
+				MarkdownDescription: "Number of matching fields set in the Criteria (`app_id`, `env_id`, `env_type`, `res_id` and `class` if it isn't `default`), calculated by the provider. Criteria with a higher score are more specific.",
+				Computed:            true,
+			},
+			"active_resources_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of Active Resources provisioned through the Criteria, refreshed on every read. Criteria without Active Resources aren't in use.",
+				Computed:            true,
+			},
 			"force_delete": schema.BoolAttribute{
 				MarkdownDescription: "If set to `true`, the Matching Criteria is deleted immediately, even if this action affects existing Active Resources.",
 				Optional:            true,
@@ -129,19 +145,14 @@ func (r *ResourceDefinitionCriteriaResource) Schema(ctx context.Context, req res
 }
 
 func (r *ResourceDefinitionCriteriaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	data, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
 		return
 	}
 
-	data, ok := req.ProviderData.(*HumanitecData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	// Prevent panic if the provider has not been configured.
+	if data == nil {
 		return
 	}
 
@@ -150,11 +161,67 @@ func (r *ResourceDefinitionCriteriaResource) Configure(ctx context.Context, req
 
 func parseResourceDefinitionCriteriaResponse(res *client.MatchingCriteriaResponse, data *ResourceDefinitionCriteriaResourceModel) {
 	data.ID = types.StringValue(res.Id)
-	data.AppID = parseOptionalString(res.AppId)
-	data.EnvID = parseOptionalString(res.EnvId)
-	data.EnvType = parseOptionalString(res.EnvType)
-	data.ResID = parseOptionalString(res.ResId)
+	data.AppID = convert.OptionalString(res.AppId)
+	data.EnvID = convert.OptionalString(res.EnvId)
+	data.EnvType = convert.OptionalString(res.EnvType)
+	data.ResID = convert.OptionalString(res.ResId)
 	data.Class = types.StringValue(res.Class)
+	data.SpecificityScore = criteriaSpecificityScore(data)
+}
+
+// criteriaSpecificityScore counts the matching fields set in the criteria, returning unknown if any of them isn't known yet.
+func criteriaSpecificityScore(data *ResourceDefinitionCriteriaResourceModel) types.Int64 {
+	var score int64
+	for _, v := range []types.String{data.AppID, data.EnvID, data.EnvType, data.ResID} {
+		if v.IsUnknown() {
+			return types.Int64Unknown()
+		}
+		if !v.IsNull() {
+			score++
+		}
+	}
+
+	if data.Class.IsUnknown() {
+		return types.Int64Unknown()
+	}
+	if !data.Class.IsNull() && data.Class.ValueString() != "default" {
+		score++
+	}
+
+	return types.Int64Value(score)
+}
+
+// countCriteriaActiveResources counts the active resources provisioned through the criteria.
+func countCriteriaActiveResources(resources *[]client.ActiveResourceResponse, criteriaID string) int64 {
+	if resources == nil {
+		return 0
+	}
+
+	var count int64
+	for _, res := range *resources {
+		if res.CriteriaId != nil && *res.CriteriaId == criteriaID {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *ResourceDefinitionCriteriaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.data.validateForceDelete(ctx, req, resp)
+	r.data.validateDestructiveRole(ctx, req, resp, "resource definition criteria", resourceDefinitionCriteriaReplaceAttributes)
+
+	// Skip destroy plans
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *ResourceDefinitionCriteriaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("specificity_score"), criteriaSpecificityScore(data))...)
 }
 
 func (r *ResourceDefinitionCriteriaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -186,6 +253,9 @@ func (r *ResourceDefinitionCriteriaResource) Create(ctx context.Context, req res
 
 	parseResourceDefinitionCriteriaResponse(httpResp.JSON200, data)
 
+	// Active resources are only provisioned through the criteria by later deployments
+	data.ActiveResourcesCount = types.Int64Value(0)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -204,7 +274,7 @@ func (r *ResourceDefinitionCriteriaResource) Read(ctx context.Context, req resou
 		return
 	}
 
-	httpResp, err := r.client().GetResourceDefinitionWithResponse(ctx, r.orgId(), data.ResourceDefinitionID.ValueString(), &client.GetResourceDefinitionParams{Deleted: toPtr(false)})
+	httpResp, err := r.client().GetResourceDefinitionWithResponse(ctx, r.orgId(), data.ResourceDefinitionID.ValueString(), &client.GetResourceDefinitionParams{Deleted: convert.ToPtr(false)})
 	if err != nil {
 		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to read resource definition, got error: %s", err))
 		return
@@ -251,6 +321,24 @@ func (r *ResourceDefinitionCriteriaResource) Read(ctx context.Context, req resou
 		return
 	}
 
+	activeResp, err := r.client().ListActiveResourceByDefinitionWithResponse(ctx, r.orgId(), data.ResourceDefinitionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list active resources of resource definition, got error: %s", err))
+		return
+	}
+
+	if activeResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list active resources of resource definition, unexpected status code: %d, body: %s", activeResp.StatusCode(), activeResp.Body))
+		return
+	}
+
+	data.ActiveResourcesCount = types.Int64Value(countCriteriaActiveResources(activeResp.JSON200, data.ID.ValueString()))
+
+	// force_delete is client-only, fall back to its default after an import
+	if data.ForceDelete.IsNull() {
+		data.ForceDelete = types.BoolValue(false)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/humanitec/humanitec-go-autogen/client"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccResourceDefinitionCriteria(t *testing.T) {
@@ -47,6 +50,20 @@ func TestAccResourceDefinitionCriteria(t *testing.T) {
 			},
 			resourceAttrNameUpdateValue2: "true",
 		},
+		{
+			name: "WithSpecificityScore",
+			configCreate: func() string {
+				return testAccResourceDefinitionAndCriteriaResource("my-app-1")
+			},
+			resourceAttrNameIDValue:      "s3-test",
+			resourceAttrNameUpdateKey:    "specificity_score",
+			resourceAttrNameUpdateValue1: "1",
+			resourceAttrName:             "humanitec_resource_definition_criteria.s3_test",
+			configUpdate: func() string {
+				return testAccResourceDefinitionAndCriteriaResourceWithClass("my-app-1", "ephemeral")
+			},
+			resourceAttrNameUpdateValue2: "2",
+		},
 		{
 			name: "WithClass",
 			configCreate: func() string {
@@ -65,7 +82,7 @@ func TestAccResourceDefinitionCriteria(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			resource.Test(t, resource.TestCase{
+			testAccTest(t, resource.TestCase{
 				PreCheck:                 func() { testAccPreCheck(t) },
 				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 				Steps: []resource.TestStep{
@@ -75,6 +92,7 @@ func TestAccResourceDefinitionCriteria(t *testing.T) {
 						Check: resource.ComposeAggregateTestCheckFunc(
 							resource.TestCheckResourceAttr(tc.resourceAttrName, "resource_definition_id", tc.resourceAttrNameIDValue),
 							resource.TestCheckResourceAttr(tc.resourceAttrName, tc.resourceAttrNameUpdateKey, tc.resourceAttrNameUpdateValue1),
+							resource.TestCheckResourceAttr(tc.resourceAttrName, "active_resources_count", "0"),
 						),
 					},
 					// ImportState testing
@@ -144,6 +162,10 @@ resource "humanitec_resource_definition_criteria" "s3_test" {
 
 func testAccResourceDefinitionAndCriteriaResourceWithForceDelete(appID, forceDelete string) string {
 	return fmt.Sprintf(`
+provider "humanitec" {
+  allow_force_delete = true
+}
+
 resource "humanitec_resource_definition" "s3_test" {
   id          = "s3-test"
   name        = "s3-test"
@@ -188,3 +210,68 @@ resource "humanitec_resource_definition_criteria" "s3_test" {
 }
 `, appID, class)
 }
+
+func TestCriteriaSpecificityScore(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     *ResourceDefinitionCriteriaResourceModel
+		expected types.Int64
+	}{
+		{
+			name: "default class only",
+			data: &ResourceDefinitionCriteriaResourceModel{
+				AppID:   types.StringNull(),
+				EnvID:   types.StringNull(),
+				EnvType: types.StringNull(),
+				ResID:   types.StringNull(),
+				Class:   types.StringValue("default"),
+			},
+			expected: types.Int64Value(0),
+		},
+		{
+			name: "all fields",
+			data: &ResourceDefinitionCriteriaResourceModel{
+				AppID:   types.StringValue("app"),
+				EnvID:   types.StringValue("development"),
+				EnvType: types.StringValue("development"),
+				ResID:   types.StringValue("modules.workload"),
+				Class:   types.StringValue("ephemeral"),
+			},
+			expected: types.Int64Value(5),
+		},
+		{
+			name: "unknown field",
+			data: &ResourceDefinitionCriteriaResourceModel{
+				AppID:   types.StringUnknown(),
+				EnvID:   types.StringNull(),
+				EnvType: types.StringNull(),
+				ResID:   types.StringNull(),
+				Class:   types.StringValue("default"),
+			},
+			expected: types.Int64Unknown(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, criteriaSpecificityScore(tc.data))
+		})
+	}
+}
+
+func TestCountCriteriaActiveResources(t *testing.T) {
+	assert := assert.New(t)
+
+	criteria1, criteria2 := "criteria-1", "criteria-2"
+	resources := []client.ActiveResourceResponse{
+		{GuResId: "a", CriteriaId: &criteria1},
+		{GuResId: "b", CriteriaId: &criteria2},
+		{GuResId: "c", CriteriaId: &criteria1},
+		{GuResId: "d"},
+	}
+
+	assert.Equal(int64(2), countCriteriaActiveResources(&resources, "criteria-1"))
+	assert.Equal(int64(1), countCriteriaActiveResources(&resources, "criteria-2"))
+	assert.Equal(int64(0), countCriteriaActiveResources(&resources, "unused"))
+	assert.Equal(int64(0), countCriteriaActiveResources(nil, "criteria-1"))
+}
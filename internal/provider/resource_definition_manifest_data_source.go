@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/convert"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ResourceDefinitionManifestDataSource{}
+
+const (
+	humctlEntityAPIVersion = "entity.humanitec.io/v1b1"
+	humctlDefinitionKind   = "Definition"
+)
+
+func NewResourceDefinitionManifestDataSource() datasource.DataSource {
+	return &ResourceDefinitionManifestDataSource{}
+}
+
+// ResourceDefinitionManifestDataSource defines the data source implementation.
+type ResourceDefinitionManifestDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// ResourceDefinitionManifestDataSourceModel describes the data source data model.
+type ResourceDefinitionManifestDataSourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	YAML types.String `tfsdk:"yaml"`
+}
+
+// humctlManifest is the file format accepted by humctl diff and apply.
+type humctlManifest struct {
+	APIVersion string                 `json:"apiVersion"`
+	Kind       string                 `json:"kind"`
+	Metadata   humctlManifestMetadata `json:"metadata"`
+	Entity     interface{}            `json:"entity"`
+}
+
+type humctlManifestMetadata struct {
+	ID string `json:"id"`
+}
+
+type humctlDefinitionEntity struct {
+	Name          string                     `json:"name"`
+	Type          string                     `json:"type"`
+	DriverType    string                     `json:"driver_type"`
+	DriverAccount string                     `json:"driver_account,omitempty"`
+	DriverInputs  *humctlDriverInputs        `json:"driver_inputs,omitempty"`
+	Provision     map[string]humctlProvision `json:"provision,omitempty"`
+	Criteria      []humctlCriteria           `json:"criteria,omitempty"`
+}
+
+type humctlDriverInputs struct {
+	Values     map[string]interface{} `json:"values,omitempty"`
+	SecretRefs map[string]interface{} `json:"secret_refs,omitempty"`
+}
+
+type humctlProvision struct {
+	IsDependent     bool `json:"is_dependent"`
+	MatchDependents bool `json:"match_dependents"`
+}
+
+type humctlCriteria struct {
+	AppID   string `json:"app_id,omitempty"`
+	EnvType string `json:"env_type,omitempty"`
+	EnvID   string `json:"env_id,omitempty"`
+	ResID   string `json:"res_id,omitempty"`
+	Class   string `json:"class,omitempty"`
+}
+
+func (d *ResourceDefinitionManifestDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_definition_manifest"
+}
+
+func (d *ResourceDefinitionManifestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Renders a Resource Definition in the YAML format accepted by `humctl diff` and `humctl apply`, so that developers can compare their local changes against the definition managed by Terraform. Secrets are only included as `secret_refs`. Pipelines don't need to be converted, the `definition` of `humanitec_pipeline` is already accepted by humctl.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The Resource Definition ID.",
+				Required:            true,
+			},
+			"yaml": schema.StringAttribute{
+				MarkdownDescription: "The Resource Definition with its matching criteria as humctl YAML.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ResourceDefinitionManifestDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *ResourceDefinitionManifestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ResourceDefinitionManifestDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defID := data.ID.ValueString()
+
+	httpResp, err := d.client.GetResourceDefinitionWithResponse(ctx, d.orgId, defID, &client.GetResourceDefinitionParams{Deleted: convert.ToPtr(false)})
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to read resource definition, got error: %s", err))
+		return
+	}
+	if httpResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to read resource definition, unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	manifest, err := yaml.Marshal(newHumctlDefinitionManifest(httpResp.JSON200))
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_PROVIDER_ERR, fmt.Sprintf("Unable to encode resource definition as YAML, got error: %s", err))
+		return
+	}
+
+	data.YAML = types.StringValue(string(manifest))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newHumctlDefinitionManifest converts a resource definition into the humctl file format.
+func newHumctlDefinitionManifest(res *client.ResourceDefinitionResponse) humctlManifest {
+	entity := humctlDefinitionEntity{
+		Name:       res.Name,
+		Type:       res.Type,
+		DriverType: res.DriverType,
+	}
+	if res.DriverAccount != nil {
+		entity.DriverAccount = *res.DriverAccount
+	}
+
+	if res.DriverInputs != nil {
+		inputs := &humctlDriverInputs{}
+		if res.DriverInputs.Values != nil {
+			inputs.Values = *res.DriverInputs.Values
+		}
+		if res.DriverInputs.SecretRefs != nil {
+			inputs.SecretRefs = *res.DriverInputs.SecretRefs
+		}
+		if len(inputs.Values) > 0 || len(inputs.SecretRefs) > 0 {
+			entity.DriverInputs = inputs
+		}
+	}
+
+	if res.Provision != nil && len(*res.Provision) > 0 {
+		entity.Provision = map[string]humctlProvision{}
+		for k, v := range *res.Provision {
+			entity.Provision[k] = humctlProvision{
+				IsDependent:     v.IsDependent,
+				MatchDependents: convert.BoolOrFalse(v.MatchDependents).ValueBool(),
+			}
+		}
+	}
+
+	if res.Criteria != nil {
+		for _, c := range *res.Criteria {
+			entity.Criteria = append(entity.Criteria, humctlCriteria{
+				AppID:   convert.OptionalString(c.AppId).ValueString(),
+				EnvType: convert.OptionalString(c.EnvType).ValueString(),
+				EnvID:   convert.OptionalString(c.EnvId).ValueString(),
+				ResID:   convert.OptionalString(c.ResId).ValueString(),
+				Class:   c.Class,
+			})
+		}
+	}
+
+	return humctlManifest{
+		APIVersion: humctlEntityAPIVersion,
+		Kind:       humctlDefinitionKind,
+		Metadata:   humctlManifestMetadata{ID: res.Id},
+		Entity:     entity,
+	}
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/humanitec/humanitec-go-autogen/client"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/convert"
+)
+
+func TestAccResourceDefinitionManifestDataSource(t *testing.T) {
+	id := fmt.Sprintf("manifest-test-%d", time.Now().UnixNano())
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDefinitionManifestDataSourceConfig(id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.humanitec_resource_definition_manifest.test", "yaml", fmt.Sprintf(`apiVersion: entity.humanitec.io/v1b1
+entity:
+  driver_inputs:
+    values:
+      region: us-east-1
+  driver_type: humanitec/s3
+  name: s3-test
+  type: s3
+kind: Definition
+metadata:
+  id: %s
+`, id)),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDefinitionManifestDataSourceConfig(id string) string {
+	return testAccResourceDefinitionS3Resource(id, "us-east-1") + `
+data "humanitec_resource_definition_manifest" "test" {
+  id = humanitec_resource_definition.s3_test.id
+}
+`
+}
+
+func TestNewHumctlDefinitionManifest(t *testing.T) {
+	manifest := newHumctlDefinitionManifest(&client.ResourceDefinitionResponse{
+		Id:            "postgres",
+		Name:          "Postgres",
+		Type:          "postgres",
+		DriverType:    "humanitec/postgres-cloudsql",
+		DriverAccount: convert.ToPtr("gcp-account"),
+		DriverInputs: &client.ValuesSecretsRefsResponse{
+			Values:     &map[string]interface{}{"instance": "test:test:test"},
+			SecretRefs: &map[string]interface{}{"password": map[string]interface{}{"ref": "path", "store": "vault"}},
+		},
+		Provision: &map[string]client.ProvisionDependenciesResponse{
+			"aws-policy": {IsDependent: true},
+		},
+		Criteria: &[]client.MatchingCriteriaResponse{
+			{Id: "c1", AppId: convert.ToPtr("my-app"), Class: "default"},
+		},
+	})
+
+	content, err := yaml.Marshal(manifest)
+	assert.NoError(t, err)
+	assert.Equal(t, `apiVersion: entity.humanitec.io/v1b1
+entity:
+  criteria:
+  - app_id: my-app
+    class: default
+  driver_account: gcp-account
+  driver_inputs:
+    secret_refs:
+      password:
+        ref: path
+        store: vault
+    values:
+      instance: test:test:test
+  driver_type: humanitec/postgres-cloudsql
+  name: Postgres
+  provision:
+    aws-policy:
+      is_dependent: true
+      match_dependents: false
+  type: postgres
+kind: Definition
+metadata:
+  id: postgres
+`, string(content))
+}
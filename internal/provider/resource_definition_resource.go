@@ -1,9 +1,15 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -17,18 +23,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"sigs.k8s.io/yaml"
 
 	"github.com/humanitec/humanitec-go-autogen"
 	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/convert"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &ResourceDefinitionResource{}
 var _ resource.ResourceWithImportState = &ResourceDefinitionResource{}
+var _ resource.ResourceWithModifyPlan = &ResourceDefinitionResource{}
 
 var defaultResourceDefinitionDeleteTimeout = 10 * time.Minute
 
+// resourceDefinitionReplaceAttributes are the attributes replacing the Resource Definition when changed.
+var resourceDefinitionReplaceAttributes = path.Paths{path.Root("id"), path.Root("type")}
+
 func NewResourceDefinitionResource() resource.Resource {
 	return &ResourceDefinitionResource{}
 }
@@ -48,9 +62,21 @@ func (r *ResourceDefinitionResource) orgId() string {
 
 // DefinitionResourceDriverInputsModel describes the resource data model.
 type DefinitionResourceDriverInputsModel struct {
-	ValuesString  types.String `tfsdk:"values_string"`
-	SecretsString types.String `tfsdk:"secrets_string"`
-	SecretRefs    types.String `tfsdk:"secret_refs"`
+	Values        types.Dynamic `tfsdk:"values"`
+	ValuesString  types.String  `tfsdk:"values_string"`
+	ValuesYAML    types.String  `tfsdk:"values_yaml"`
+	SecretsString types.String  `tfsdk:"secrets_string"`
+	SecretsYAML   types.String  `tfsdk:"secrets_yaml"`
+	SecretRefs    types.String  `tfsdk:"secret_refs"`
+
+	Manifests []DefinitionResourceManifestModel `tfsdk:"manifests"`
+}
+
+// DefinitionResourceManifestModel describes a manifest of the humanitec/template driver.
+type DefinitionResourceManifestModel struct {
+	Path     types.String `tfsdk:"path"`
+	Location types.String `tfsdk:"location"`
+	Content  types.String `tfsdk:"content"`
 }
 
 // DefinitionResourceCriteriaModel describes the resource data model.
@@ -77,6 +103,8 @@ type DefinitionResourceModel struct {
 	DriverAccount types.String                                 `tfsdk:"driver_account"`
 	DriverInputs  *DefinitionResourceDriverInputsModel         `tfsdk:"driver_inputs"`
 	Provision     *map[string]DefinitionResourceProvisionModel `tfsdk:"provision"`
+	CreatedBy     types.String                                 `tfsdk:"created_by"`
+	UpdatedBy     types.String                                 `tfsdk:"updated_by"`
 
 	ForceDelete types.Bool     `tfsdk:"force_delete"`
 	Timeouts    timeouts.Value `tfsdk:"timeouts"`
@@ -114,33 +142,94 @@ func (r *ResourceDefinitionResource) Schema(ctx context.Context, req resource.Sc
 				Required:            true,
 			},
 			"driver_account": schema.StringAttribute{
-				MarkdownDescription: "Security account required by the driver.",
+				MarkdownDescription: "Security account required by the driver. Omit it instead of setting an empty string to use no account.",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 			"driver_inputs": schema.SingleNestedAttribute{
 				MarkdownDescription: "Data that should be passed around split by sensitivity.",
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
+					"values": schema.DynamicAttribute{
+						MarkdownDescription: "Input data set as a native Terraform object. Passed around as-is. Can't be used together with values_string or values_yaml.",
+						Optional:            true,
+					},
 					"values_string": schema.StringAttribute{
-						MarkdownDescription: "JSON encoded input data set. Passed around as-is.",
+						MarkdownDescription: "JSON encoded input data set. Passed around as-is. Can't be used together with values or values_yaml.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.Expressions{
+								path.MatchRelative().AtParent().AtName("values"),
+							}...),
+						},
+					},
+					"values_yaml": schema.StringAttribute{
+						MarkdownDescription: "YAML encoded input data set, converted to JSON before it's passed around. Can't be used together with values or values_string.",
+						Optional:            true,
+						Validators: []validator.String{
+							yamlObjectValidator{},
+							stringvalidator.ConflictsWith(path.Expressions{
+								path.MatchRelative().AtParent().AtName("values"),
+								path.MatchRelative().AtParent().AtName("values_string"),
+							}...),
+						},
 					},
 					"secrets_string": schema.StringAttribute{
-						MarkdownDescription: "JSON encoded secret data set. Passed around as-is. Can't be used together with secret_refs.",
+						MarkdownDescription: "JSON encoded secret data set. Passed around as-is. Can't be used together with secret_refs or secrets_yaml. Can reference values that are only known during apply, e.g. from a secret manager data source, secret_refs is then computed during apply while the other driver inputs are planned as usual.",
 						Optional:            true,
 						Sensitive:           true,
 					},
+					"secrets_yaml": schema.StringAttribute{
+						MarkdownDescription: "YAML encoded secret data set, converted to JSON before it's passed around. Can't be used together with secret_refs or secrets_string.",
+						Optional:            true,
+						Sensitive:           true,
+						Validators: []validator.String{
+							yamlObjectValidator{},
+							stringvalidator.ConflictsWith(path.Expressions{
+								path.MatchRelative().AtParent().AtName("secrets_string"),
+							}...),
+						},
+					},
 					"secret_refs": schema.StringAttribute{
-						MarkdownDescription: "JSON encoded secrets section of the data set. They can hold sensitive information that will be stored in the primary organization secret store and replaced with the secret store paths when sent outside, or secret references stored in a defined secret store. Can't be used together with secrets.",
+						MarkdownDescription: "JSON encoded secrets section of the data set. They can hold sensitive information that will be stored in the primary organization secret store and replaced with the secret store paths when sent outside, or secret references stored in a defined secret store. Can't be used together with secrets. Switching from secrets_string to secret_refs with `value` entries updates the definition in place, the `value` entries are kept in the state while the API bumps the version of the stored secrets.",
 						Optional:            true,
 						Computed:            true,
 						Sensitive:           true,
 						Validators: []validator.String{
 							stringvalidator.ConflictsWith(path.Expressions{
 								path.MatchRelative().AtParent().AtName("secrets_string"),
+								path.MatchRelative().AtParent().AtName("secrets_yaml"),
 							}...),
 						},
 					},
+					"manifests": schema.ListNestedAttribute{
+						MarkdownDescription: "Manifests of the `humanitec/template` driver, injected as `templates.manifests` into the values. Can't be used together with `templates.manifests` in values, values_string or values_yaml.",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"path": schema.StringAttribute{
+									MarkdownDescription: "The file name of the manifest, e.g. `deployment-annotations.yaml`.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(templateManifestPathRegexp, "must be a file name of letters, digits, '.', '_' and '-'"),
+									},
+								},
+								"location": schema.StringAttribute{
+									MarkdownDescription: "Where the manifest is applied, e.g. `cluster`, `namespace` or `containers`. Defaults to `namespace`.",
+									Optional:            true,
+								},
+								"content": schema.StringAttribute{
+									MarkdownDescription: "The YAML content of the manifest, validated during plan. Template expressions have to be quoted, e.g. `name: \"{{ .id }}\"`.",
+									Required:            true,
+									Validators: []validator.String{
+										templateManifestContentValidator{},
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"provision": schema.MapNestedAttribute{
@@ -167,6 +256,17 @@ func (r *ResourceDefinitionResource) Schema(ctx context.Context, req resource.Sc
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user who created the Resource Definition.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_by": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user who last updated the Resource Definition.",
+				Computed:            true,
+			},
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Delete: true,
 			}),
@@ -175,31 +275,189 @@ func (r *ResourceDefinitionResource) Schema(ctx context.Context, req resource.Sc
 }
 
 func (r *ResourceDefinitionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
+		return
+	}
+
 	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	if data == nil {
 		return
 	}
 
-	data, ok := req.ProviderData.(*HumanitecData)
+	r.data = data
+}
 
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+func (r *ResourceDefinitionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.data.validateForceDelete(ctx, req, resp)
+	r.data.validateDestructiveRole(ctx, req, resp, "resource definition", resourceDefinitionReplaceAttributes)
+	r.data.validateIDConvention(ctx, req, resp, "resource definition")
 
+	// Skip destroy plans
+	if req.Plan.Raw.IsNull() {
 		return
 	}
 
-	r.data = data
+	var secretsString, secretsYAML types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("driver_inputs").AtName("secrets_string"), &secretsString)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("driver_inputs").AtName("secrets_yaml"), &secretsYAML)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// secrets_yaml is tracked the same way as secrets_string, they can't be used together
+	secretsAttribute := "secrets_string"
+	if secretsString.IsNull() {
+		secretsString = secretsYAML
+		secretsAttribute = "secrets_yaml"
+	}
+
+	r.planSecretsStringChange(ctx, req, secretsString, resp)
+	r.warnPlaintextSecrets(ctx, secretsString, secretsAttribute, resp)
 }
 
-func parseOptionalString(input *string) types.String {
-	if input == nil {
-		return types.StringNull()
+// planSecretsStringChange marks secret_refs as unknown when the configured secrets_string doesn't match the hash stored in the private state,
+// so that editing or removing secrets always results in an update. An unknown secrets_string, e.g. read from a secret manager data source
+// that is only known during apply, is treated as a change, the other driver inputs are planned as usual.
+func (r *ResourceDefinitionResource) planSecretsStringChange(ctx context.Context, req resource.ModifyPlanRequest, secretsString types.String, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare against on create
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	if !secretsString.IsUnknown() {
+		storedHash, diags := req.Private.GetKey(ctx, secretsStringHashPrivateKey)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() || storedHash == nil {
+			return
+		}
+
+		if secretsStringHashMatches(storedHash, secretsString) {
+			return
+		}
 	}
 
-	return types.StringValue(*input)
+	var secretRefs types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("driver_inputs").AtName("secret_refs"), &secretRefs)...)
+	if resp.Diagnostics.HasError() || !secretRefs.IsNull() {
+		return
+	}
+
+	var driverInputs types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("driver_inputs"), &driverInputs)...)
+	if resp.Diagnostics.HasError() || driverInputs.IsNull() || driverInputs.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("driver_inputs").AtName("secret_refs"), types.StringUnknown())...)
+}
+
+// warnPlaintextSecrets warns when secrets_string or secrets_yaml is used while the primary secret store of the organization is an external one.
+func (r *ResourceDefinitionResource) warnPlaintextSecrets(ctx context.Context, secretsString types.String, secretsAttribute string, resp *resource.ModifyPlanResponse) {
+	if r.data == nil || !r.data.WarnPlaintextSecrets || secretsString.IsNull() {
+		return
+	}
+
+	primaryStore, err := r.data.getPrimarySecretStore(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "can't look up the primary secret store", map[string]interface{}{"err": err.Error()})
+		return
+	}
+
+	if primaryStore != nil && primaryStore.Humanitec == nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("driver_inputs").AtName(secretsAttribute),
+			"Plaintext secrets used with an external primary secret store",
+			fmt.Sprintf("The primary secret store %q of the organization is an external one, consider using driver_inputs.secret_refs to reference secrets stored there instead of passing them in driver_inputs.%s. This warning can be disabled with the provider warn_plaintext_secrets attribute.", primaryStore.Id, secretsAttribute),
+		)
+	}
+}
+
+const secretsStringHashPrivateKey = "secrets_string_hash"
+
+// secretsStringHash is stored in the private state to detect changes of secrets_string without keeping the secrets around.
+type secretsStringHash struct {
+	Salt []byte `json:"salt"`
+	Hash []byte `json:"hash"`
+}
+
+func hashSecretsString(salt []byte, secretsString string) []byte {
+	h := sha256.New()
+	h.Write(salt)
+	h.Write([]byte(secretsString))
+	return h.Sum(nil)
+}
+
+// newSecretsStringHash returns the private state value for the secrets_string or nil if it isn't set.
+func newSecretsStringHash(secretsString types.String) ([]byte, error) {
+	if secretsString.IsNull() || secretsString.IsUnknown() {
+		return nil, nil
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(secretsStringHash{
+		Salt: salt,
+		Hash: hashSecretsString(salt, secretsString.ValueString()),
+	})
+}
+
+// secretsStringHashMatches reports whether the private state value matches the secrets_string.
+func secretsStringHashMatches(storedHash []byte, secretsString types.String) bool {
+	var stored secretsStringHash
+	if err := json.Unmarshal(storedHash, &stored); err != nil {
+		return false
+	}
+
+	if secretsString.IsNull() {
+		return false
+	}
+
+	return bytes.Equal(stored.Hash, hashSecretsString(stored.Salt, secretsString.ValueString()))
+}
+
+// setSecretsStringHash stores the hash of the secrets_string in the private state, removing it if secrets_string isn't set.
+func setSecretsStringHash(ctx context.Context, private privateState, data *DefinitionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	secretsString := types.StringNull()
+	if data.DriverInputs != nil {
+		secretsString = data.DriverInputs.SecretsString
+		if secretsString.IsNull() {
+			secretsString = data.DriverInputs.SecretsYAML
+		}
+	}
+
+	value, err := newSecretsStringHash(secretsString)
+	if err != nil {
+		diags.AddError(HUM_PROVIDER_ERR, fmt.Sprintf("Failed to hash secrets_string: %s", err.Error()))
+		return diags
+	}
+
+	return private.SetKey(ctx, secretsStringHashPrivateKey, value)
+}
+
+// privateState is implemented by the private state of resource responses.
+type privateState interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// parseDriverAccount treats an empty driver account, as returned after it was unset e.g. in the UI, the same as a missing one.
+func parseDriverAccount(input *string) types.String {
+	return convert.NonEmptyString(input)
+}
+
+// driverAccountUpdate returns the driver account of an update request, an empty string unsets it as a missing one keeps the current value.
+func driverAccountUpdate(driverAccount types.String) *string {
+	if driverAccount.IsNull() {
+		return convert.ToPtr("")
+	}
+
+	return driverAccount.ValueStringPointer()
 }
 
 func parseProvisionInput(provision *map[string]client.ProvisionDependenciesResponse) *map[string]DefinitionResourceProvisionModel {
@@ -211,22 +469,13 @@ func parseProvisionInput(provision *map[string]client.ProvisionDependenciesRespo
 	for k, v := range *provision {
 		data[k] = DefinitionResourceProvisionModel{
 			IsDependant:     types.BoolValue(v.IsDependent),
-			MatchDependents: defaultFalseBoolValuePointer(v.MatchDependents),
+			MatchDependents: convert.BoolOrFalse(v.MatchDependents),
 		}
 	}
 
 	return &data
 }
 
-// defaultFalseBoolValuePointer returns a types.Bool value of false if the pointer is nil, otherwise it returns the value of the pointer.
-func defaultFalseBoolValuePointer(b *bool) types.Bool {
-	if b == nil {
-		return types.BoolValue(false)
-	}
-
-	return types.BoolValue(*b)
-}
-
 func parseResourceDefinitionResponse(res *client.ResourceDefinitionResponse, data *DefinitionResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -234,24 +483,45 @@ func parseResourceDefinitionResponse(res *client.ResourceDefinitionResponse, dat
 	data.Name = types.StringValue(res.Name)
 	data.Type = types.StringValue(res.Type)
 	data.DriverType = types.StringValue(res.DriverType)
-	data.DriverAccount = parseOptionalString(res.DriverAccount)
+	data.DriverAccount = parseDriverAccount(res.DriverAccount)
 	data.Provision = parseProvisionInput(res.Provision)
+	data.CreatedBy = types.StringValue(res.CreatedBy)
+	data.UpdatedBy = types.StringPointerValue(res.UpdatedBy)
 
 	driverInputs := res.DriverInputs
 
+	var values map[string]interface{}
 	if driverInputs != nil && driverInputs.Values != nil {
+		values = *driverInputs.Values
+		if data.DriverInputs != nil && len(data.DriverInputs.Manifests) > 0 {
+			values = stripTemplateManifests(values, data.DriverInputs.Manifests)
+			// Only manifests are configured
+			if len(values) == 0 && data.DriverInputs.Values.IsNull() && data.DriverInputs.ValuesString.IsNull() && data.DriverInputs.ValuesYAML.IsNull() {
+				values = nil
+			}
+		}
+	}
+
+	if values != nil {
 		if data.DriverInputs == nil {
 			data.DriverInputs = &DefinitionResourceDriverInputsModel{
+				Values:        types.DynamicNull(),
 				SecretsString: types.StringNull(),
 				SecretRefs:    types.StringNull(),
 			}
 		}
 
-		b, err := json.Marshal(driverInputs.Values)
-		if err != nil {
-			diags.AddError(HUM_API_ERR, fmt.Sprintf("Failed to marshal values: %s", err.Error()))
+		if !data.DriverInputs.Values.IsNull() {
+			diags.Append(parseResourceDefinitionValuesResponse(values, data)...)
+		} else if !data.DriverInputs.ValuesYAML.IsNull() {
+			diags.Append(parseResourceDefinitionValuesYAMLResponse(values, data)...)
+		} else {
+			b, err := json.Marshal(values)
+			if err != nil {
+				diags.AddError(HUM_API_ERR, fmt.Sprintf("Failed to marshal values: %s", err.Error()))
+			}
+			data.DriverInputs.ValuesString = types.StringValue(string(b))
 		}
-		data.DriverInputs.ValuesString = types.StringValue(string(b))
 	}
 
 	if data.DriverInputs != nil {
@@ -260,6 +530,40 @@ func parseResourceDefinitionResponse(res *client.ResourceDefinitionResponse, dat
 	return diags
 }
 
+// parseResourceDefinitionValuesResponse stores the API values in the dynamic values attribute, keeping the configured value when it is equal to the API one.
+func parseResourceDefinitionValuesResponse(values map[string]interface{}, data *DefinitionResourceModel) diag.Diagnostics {
+	if existing, err := attrValueToInterface(data.DriverInputs.Values); err == nil && reflect.DeepEqual(existing, values) {
+		return nil
+	}
+
+	value, diags := interfaceToAttrValue(values)
+	if diags.HasError() {
+		return diags
+	}
+	data.DriverInputs.Values = types.DynamicValue(value)
+
+	return diags
+}
+
+// parseResourceDefinitionValuesYAMLResponse stores the API values in values_yaml, keeping the configured YAML when it is equal to the API values.
+func parseResourceDefinitionValuesYAMLResponse(values map[string]interface{}, data *DefinitionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var existing map[string]interface{}
+	if err := yaml.Unmarshal([]byte(data.DriverInputs.ValuesYAML.ValueString()), &existing); err == nil && reflect.DeepEqual(existing, values) {
+		return diags
+	}
+
+	b, err := yaml.Marshal(values)
+	if err != nil {
+		diags.AddError(HUM_API_ERR, fmt.Sprintf("Failed to marshal values: %s", err.Error()))
+		return diags
+	}
+	data.DriverInputs.ValuesYAML = types.StringValue(string(b))
+
+	return diags
+}
+
 func parseResourceDefinitionSecretRefResponse(secretRefs *map[string]interface{}, data *DefinitionResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -403,6 +707,10 @@ func driverInputsFromModel(data *DefinitionResourceModel) (*client.ValuesSecrets
 		if err := json.Unmarshal([]byte(data.DriverInputs.SecretsString.ValueString()), &secrets); err != nil {
 			secretsDiag.AddError(HUM_INPUT_ERR, fmt.Sprintf("Failed to unmarshal secrets_string: %s", err.Error()))
 		}
+	} else if !data.DriverInputs.SecretsYAML.IsNull() {
+		if err := yaml.Unmarshal([]byte(data.DriverInputs.SecretsYAML.ValueString()), &secrets); err != nil {
+			secretsDiag.AddError(HUM_INPUT_ERR, fmt.Sprintf("Failed to unmarshal secrets_yaml: %s", err.Error()))
+		}
 	} else if !data.DriverInputs.SecretRefs.IsUnknown() {
 		if err := json.Unmarshal([]byte(data.DriverInputs.SecretRefs.ValueString()), &secretRefs); err != nil {
 			secretsDiag.AddError(HUM_INPUT_ERR, fmt.Sprintf("Failed to unmarshal secret_refs: %s", err.Error()))
@@ -419,12 +727,31 @@ func driverInputsFromModel(data *DefinitionResourceModel) (*client.ValuesSecrets
 	var values map[string]interface{}
 	var valuesDiag diag.Diagnostics
 
-	if !data.DriverInputs.ValuesString.IsNull() {
+	if !data.DriverInputs.Values.IsNull() {
+		v, err := attrValueToInterface(data.DriverInputs.Values)
+		if err != nil {
+			valuesDiag.AddError(HUM_INPUT_ERR, fmt.Sprintf("Failed to convert values: %s", err.Error()))
+		} else if m, ok := v.(map[string]interface{}); ok {
+			values = m
+		} else {
+			valuesDiag.AddError(HUM_INPUT_ERR, fmt.Sprintf("values must be an object, got: %T", v))
+		}
+	} else if !data.DriverInputs.ValuesString.IsNull() {
 		if err := json.Unmarshal([]byte(data.DriverInputs.ValuesString.ValueString()), &values); err != nil {
 			valuesDiag.AddError(HUM_INPUT_ERR, fmt.Sprintf("Failed to unmarshal values_string: %s", err.Error()))
 		}
+	} else if !data.DriverInputs.ValuesYAML.IsNull() {
+		if err := yaml.Unmarshal([]byte(data.DriverInputs.ValuesYAML.ValueString()), &values); err != nil {
+			valuesDiag.AddError(HUM_INPUT_ERR, fmt.Sprintf("Failed to unmarshal values_yaml: %s", err.Error()))
+		}
 	}
 	diags.Append(valuesDiag...)
+	if len(data.DriverInputs.Manifests) > 0 && !valuesDiag.HasError() {
+		if values == nil {
+			values = map[string]interface{}{}
+		}
+		diags.Append(injectTemplateManifests(values, data.DriverInputs.Manifests)...)
+	}
 	if values != nil {
 		driverInputs.Values = &values
 	}
@@ -432,6 +759,152 @@ func driverInputsFromModel(data *DefinitionResourceModel) (*client.ValuesSecrets
 	return driverInputs, diags
 }
 
+// driverInputsUnchanged reports whether the planned driver inputs are the same as the ones in the state.
+// secret_refs is only compared when known, as it's computed from secrets_string and unknown on every update otherwise.
+func driverInputsUnchanged(plan, state *DefinitionResourceDriverInputsModel) bool {
+	if plan == nil || state == nil {
+		return plan == nil && state == nil
+	}
+
+	return plan.Values.Equal(state.Values) &&
+		plan.ValuesString.Equal(state.ValuesString) &&
+		plan.ValuesYAML.Equal(state.ValuesYAML) &&
+		plan.SecretsString.Equal(state.SecretsString) &&
+		plan.SecretsYAML.Equal(state.SecretsYAML) &&
+		(plan.SecretRefs.IsUnknown() || plan.SecretRefs.Equal(state.SecretRefs)) &&
+		renderTemplateManifests(plan.Manifests) == renderTemplateManifests(state.Manifests)
+}
+
+var templateManifestPathRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+const defaultTemplateManifestLocation = "namespace"
+
+// renderTemplateManifests renders manifests in the format of the templates.manifests input of the humanitec/template driver.
+// The content is indented as-is instead of being re-encoded, so that template expressions in it are kept.
+func renderTemplateManifests(manifests []DefinitionResourceManifestModel) string {
+	var b strings.Builder
+	for _, manifest := range manifests {
+		location := manifest.Location.ValueString()
+		if location == "" {
+			location = defaultTemplateManifestLocation
+		}
+
+		fmt.Fprintf(&b, "%s:\n  location: %s\n  data:\n", manifest.Path.ValueString(), location)
+		for _, line := range strings.Split(strings.TrimRight(manifest.Content.ValueString(), "\n"), "\n") {
+			if strings.TrimSpace(line) == "" {
+				b.WriteString("\n")
+				continue
+			}
+			b.WriteString("    " + line + "\n")
+		}
+	}
+	return b.String()
+}
+
+// injectTemplateManifests sets templates.manifests in values to the rendered manifests.
+func injectTemplateManifests(values map[string]interface{}, manifests []DefinitionResourceManifestModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	templates := map[string]interface{}{}
+	if existing, ok := values["templates"]; ok {
+		if templates, ok = existing.(map[string]interface{}); !ok {
+			diags.AddError(HUM_INPUT_ERR, fmt.Sprintf("templates in values must be an object to inject manifests, got: %T", existing))
+			return diags
+		}
+	}
+
+	if _, ok := templates["manifests"]; ok {
+		diags.AddError(HUM_INPUT_ERR, "manifests can't be used together with templates.manifests in values, values_string or values_yaml.")
+		return diags
+	}
+
+	templates["manifests"] = renderTemplateManifests(manifests)
+	values["templates"] = templates
+
+	return diags
+}
+
+// stripTemplateManifests returns a copy of values without the templates.manifests injected for manifests.
+// Values are returned as-is if templates.manifests was changed outside of Terraform, so that the drift shows up in the plan.
+func stripTemplateManifests(values map[string]interface{}, manifests []DefinitionResourceManifestModel) map[string]interface{} {
+	templates, ok := values["templates"].(map[string]interface{})
+	if !ok || templates["manifests"] != renderTemplateManifests(manifests) {
+		return values
+	}
+
+	stripped := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		stripped[k] = v
+	}
+
+	strippedTemplates := make(map[string]interface{}, len(templates))
+	for k, v := range templates {
+		if k != "manifests" {
+			strippedTemplates[k] = v
+		}
+	}
+	if len(strippedTemplates) > 0 {
+		stripped["templates"] = strippedTemplates
+	} else {
+		delete(stripped, "templates")
+	}
+
+	return stripped
+}
+
+// templateManifestContentValidator ensures the content of a manifest is a YAML object.
+type templateManifestContentValidator struct{}
+
+func (v templateManifestContentValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v templateManifestContentValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a YAML object"
+}
+
+func (v templateManifestContentValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var content interface{}
+	if err := yaml.Unmarshal([]byte(req.ConfigValue.ValueString()), &content); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, HUM_INPUT_ERR,
+			fmt.Sprintf("Manifest is not valid YAML, template expressions have to be quoted: %s", err))
+		return
+	}
+	if _, ok := content.(map[string]interface{}); !ok {
+		resp.Diagnostics.AddAttributeError(req.Path, HUM_INPUT_ERR, fmt.Sprintf("Manifest must be a YAML object, got: %T", content))
+	}
+}
+
+// yamlObjectValidator ensures a string is a YAML object.
+type yamlObjectValidator struct{}
+
+func (v yamlObjectValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v yamlObjectValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a YAML object"
+}
+
+func (v yamlObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var content interface{}
+	if err := yaml.Unmarshal([]byte(req.ConfigValue.ValueString()), &content); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, HUM_INPUT_ERR, fmt.Sprintf("Value is not valid YAML: %s", err))
+		return
+	}
+	if _, ok := content.(map[string]interface{}); !ok {
+		resp.Diagnostics.AddAttributeError(req.Path, HUM_INPUT_ERR, fmt.Sprintf("Value must be a YAML object, got: %T", content))
+	}
+}
+
 func (r *ResourceDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *DefinitionResourceModel
 
@@ -473,6 +946,11 @@ func (r *ResourceDefinitionResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	resp.Diagnostics.Append(setSecretsStringHash(ctx, resp.Private, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -487,7 +965,7 @@ func (r *ResourceDefinitionResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	httpResp, err := r.client().GetResourceDefinitionWithResponse(ctx, r.orgId(), data.ID.ValueString(), &client.GetResourceDefinitionParams{Deleted: toPtr(false)})
+	httpResp, err := r.client().GetResourceDefinitionWithResponse(ctx, r.orgId(), data.ID.ValueString(), &client.GetResourceDefinitionParams{Deleted: convert.ToPtr(false)})
 	if err != nil {
 		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to read resource definition, got error: %s", err))
 		return
@@ -509,6 +987,11 @@ func (r *ResourceDefinitionResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	// force_delete is client-only, fall back to its default after an import
+	if data.ForceDelete.IsNull() {
+		data.ForceDelete = types.BoolValue(false)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -524,10 +1007,15 @@ func (r *ResourceDefinitionResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	driverInputs, diag := driverInputsFromModel(data)
-	resp.Diagnostics.Append(diag...)
-	if resp.Diagnostics.HasError() {
-		return
+	// Omit unchanged driver inputs, re-sending them would bump the versions of the stored secrets
+	var driverInputs *client.ValuesSecretsRefsRequest
+	if !driverInputsUnchanged(data.DriverInputs, state.DriverInputs) {
+		var diags diag.Diagnostics
+		driverInputs, diags = driverInputsFromModel(data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	defID := data.ID.ValueString()
@@ -536,7 +1024,7 @@ func (r *ResourceDefinitionResource) Update(ctx context.Context, req resource.Up
 
 	httpResp, err := r.client().UpdateResourceDefinitionWithResponse(ctx, r.orgId(), defID, client.UpdateResourceDefinitionRequestRequest{
 		DriverType:    data.DriverType.ValueStringPointer(),
-		DriverAccount: data.DriverAccount.ValueStringPointer(),
+		DriverAccount: driverAccountUpdate(data.DriverAccount),
 		DriverInputs:  driverInputs,
 		Name:          data.Name.ValueString(),
 		Provision:     provision,
@@ -556,6 +1044,11 @@ func (r *ResourceDefinitionResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	resp.Diagnostics.Append(setSecretsStringHash(ctx, resp.Private, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -1,16 +1,29 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strconv"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/convert"
 )
 
 type stringErrFn func() (string, error)
@@ -55,7 +68,7 @@ func TestAccResourceDefinition(t *testing.T) {
 				return testAccResourceDefinitionS3ResourceWithDifferentDriver(fmt.Sprintf("s3-test-%d", timestamp), "humanitec/terraform")
 			},
 			resourceAttrNameUpdateValue2: staticString("humanitec/terraform"),
-			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string", "force_delete"},
+			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string"},
 		},
 		{
 			name: "S3 - check the driver does not change",
@@ -70,7 +83,7 @@ func TestAccResourceDefinition(t *testing.T) {
 				return testAccResourceDefinitionS3Resource(fmt.Sprintf("s3-test-%d", timestamp), "us-east-1")
 			},
 			resourceAttrNameUpdateValue2: staticString("humanitec/s3"),
-			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string", "force_delete"},
+			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string"},
 		},
 		{
 			name: "S3",
@@ -85,7 +98,52 @@ func TestAccResourceDefinition(t *testing.T) {
 				return testAccResourceDefinitionS3Resource(fmt.Sprintf("s3-test-%d", timestamp), "us-east-2")
 			},
 			resourceAttrNameUpdateValue2: jsonString(map[string]interface{}{"region": "us-east-2"}),
-			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string", "force_delete"},
+			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string"},
+		},
+		{
+			name: "S3 - native values",
+			configCreate: func() string {
+				return testAccResourceDefinitionS3ResourceWithValues(fmt.Sprintf("s3-values-test-%d", timestamp), "us-east-1")
+			},
+			resourceAttrNameIDValue:      fmt.Sprintf("s3-values-test-%d", timestamp),
+			resourceAttrNameUpdateKey:    "driver_inputs.values.region",
+			resourceAttrNameUpdateValue1: staticString("us-east-1"),
+			resourceAttrName:             "humanitec_resource_definition.s3_test",
+			configUpdate: func() string {
+				return testAccResourceDefinitionS3ResourceWithValues(fmt.Sprintf("s3-values-test-%d", timestamp), "us-east-2")
+			},
+			resourceAttrNameUpdateValue2: staticString("us-east-2"),
+			importStateVerifyIgnore:      []string{"driver_inputs.values", "driver_inputs.values_string", "driver_inputs.secrets_string"},
+		},
+		{
+			name: "S3 - YAML values",
+			configCreate: func() string {
+				return testAccResourceDefinitionS3ResourceWithYAMLValues(fmt.Sprintf("s3-yaml-test-%d", timestamp), "us-east-1")
+			},
+			resourceAttrNameIDValue:      fmt.Sprintf("s3-yaml-test-%d", timestamp),
+			resourceAttrNameUpdateKey:    "driver_inputs.values_yaml",
+			resourceAttrNameUpdateValue1: staticString("region: us-east-1\n"),
+			resourceAttrName:             "humanitec_resource_definition.s3_test",
+			configUpdate: func() string {
+				return testAccResourceDefinitionS3ResourceWithYAMLValues(fmt.Sprintf("s3-yaml-test-%d", timestamp), "us-east-2")
+			},
+			resourceAttrNameUpdateValue2: staticString("region: us-east-2\n"),
+			importStateVerifyIgnore:      []string{"driver_inputs.values_yaml", "driver_inputs.values_string"},
+		},
+		{
+			name: "Template - manifests",
+			configCreate: func() string {
+				return testAccResourceDefinitionTemplateManifestsResource(fmt.Sprintf("template-test-%d", timestamp), "v1")
+			},
+			resourceAttrNameIDValue:      fmt.Sprintf("template-test-%d", timestamp),
+			resourceAttrNameUpdateKey:    "driver_inputs.manifests.0.content",
+			resourceAttrNameUpdateValue1: staticString("metadata:\n  annotations:\n    version: v1\n"),
+			resourceAttrName:             "humanitec_resource_definition.template_test",
+			configUpdate: func() string {
+				return testAccResourceDefinitionTemplateManifestsResource(fmt.Sprintf("template-test-%d", timestamp), "v2")
+			},
+			resourceAttrNameUpdateValue2: staticString("metadata:\n  annotations:\n    version: v2\n"),
+			importStateVerifyIgnore:      []string{"driver_inputs.manifests", "driver_inputs.values_string"},
 		},
 		{
 			name: "Postgres",
@@ -100,7 +158,7 @@ func TestAccResourceDefinition(t *testing.T) {
 				return testAccResourceDefinitionPostgresResource(fmt.Sprintf("postgres-test-%d", timestamp), "test-2")
 			},
 			resourceAttrNameUpdateValue2: jsonString(map[string]interface{}{"host": "127.0.0.1", "instance": "test:test:test", "name": "test-2", "port": 5432}),
-			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string", "force_delete"},
+			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string"},
 		},
 		{
 			name: "GKE",
@@ -115,7 +173,7 @@ func TestAccResourceDefinition(t *testing.T) {
 				return testAccResourceDefinitionGKEResource(fmt.Sprintf("gke-test-%d", timestamp), "test-2")
 			},
 			resourceAttrNameUpdateValue2: jsonString(map[string]interface{}{"loadbalancer": "1.1.1.1", "name": "test-2", "project_id": "test", "zone": "europe-west3"}),
-			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string", "force_delete"},
+			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string"},
 		},
 		{
 			name: "DNS",
@@ -130,7 +188,7 @@ func TestAccResourceDefinition(t *testing.T) {
 				return testAccResourceDefinitionDNSStaticResource(fmt.Sprintf("dns-test-%d", timestamp), "test-2")
 			},
 			resourceAttrNameUpdateValue2: jsonString(map[string]interface{}{"host": "test-2"}),
-			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string", "force_delete"},
+			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string"},
 		},
 		{
 			name: "Ingress",
@@ -145,7 +203,7 @@ func TestAccResourceDefinition(t *testing.T) {
 				return testAccResourceDefinitionIngressResource(fmt.Sprintf("ingress-test-%d", timestamp), "test-2")
 			},
 			resourceAttrNameUpdateValue2: jsonString(map[string]interface{}{"labels": map[string]interface{}{"name": "test-2"}, "no_tls": true}),
-			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string", "force_delete"},
+			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string"},
 		},
 		{
 			name: "Provision",
@@ -160,7 +218,7 @@ func TestAccResourceDefinition(t *testing.T) {
 				return testAccResourceDefinitionProvisionResource(fmt.Sprintf("provision-test-%d", timestamp), "false")
 			},
 			resourceAttrNameUpdateValue2: staticString("false"),
-			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string", "force_delete"},
+			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string"},
 		},
 		{
 			name: "k8s-logging",
@@ -175,7 +233,7 @@ func TestAccResourceDefinition(t *testing.T) {
 				return testAccResourceDefinitionK8sLoggingResource(fmt.Sprintf("k8s-logging-test-%d", timestamp), "test-2")
 			},
 			resourceAttrNameUpdateValue2: staticString("test-2"),
-			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string", "force_delete"},
+			importStateVerifyIgnore:      []string{"driver_inputs.secrets_string"},
 		},
 		{
 			name: "S3 static - secret refs",
@@ -200,7 +258,7 @@ func TestAccResourceDefinition(t *testing.T) {
 				},
 				"aws_secret_access_key": map[string]interface{}{"ref": "secretAccessKeyPath2", "store": "external-secret-store", "version": "1"},
 			}),
-			importStateVerifyIgnore: []string{"force_delete"},
+			importStateVerifyIgnore: []string{},
 		},
 		{
 			name: "S3 static - secret refs with null value", // "null" is injected when using a type like object({ .. value   = optional(string) }) in the schema
@@ -221,7 +279,7 @@ func TestAccResourceDefinition(t *testing.T) {
 				"aws_access_key_id":     map[string]interface{}{"ref": "accessKeyIdPath2", "store": "external-secret-store", "version": "1", "value": nil},
 				"aws_secret_access_key": map[string]interface{}{"ref": "secretAccessKeyPath2", "store": "external-secret-store", "version": "1", "value": nil},
 			}),
-			importStateVerifyIgnore: []string{"force_delete", "driver_inputs.secret_refs"}, // refs are ignored as "value: null" is not returned from the API
+			importStateVerifyIgnore: []string{"driver_inputs.secret_refs"}, // refs are ignored as "value: null" is not returned from the API
 		},
 		{
 			name: "S3 static - secret ref set values",
@@ -242,7 +300,7 @@ func TestAccResourceDefinition(t *testing.T) {
 				"aws_access_key_id":     map[string]interface{}{"value": "accessKeyId2"},
 				"aws_secret_access_key": map[string]interface{}{"value": "secretAccessKey2"},
 			}),
-			importStateVerifyIgnore: []string{"driver_inputs.secret_refs", "force_delete"},
+			importStateVerifyIgnore: []string{"driver_inputs.secret_refs"},
 		},
 		{
 			name: "S3 static - secret ref nested",
@@ -263,7 +321,7 @@ func TestAccResourceDefinition(t *testing.T) {
 				"aws_access_key_id":     map[string]interface{}{"value": "accessKeyId2"},
 				"aws_secret_access_key": map[string]interface{}{"value": "secretAccessKey2"},
 			}),
-			importStateVerifyIgnore: []string{"driver_inputs.secret_refs", "force_delete"},
+			importStateVerifyIgnore: []string{"driver_inputs.secret_refs"},
 		},
 	}
 
@@ -274,7 +332,7 @@ func TestAccResourceDefinition(t *testing.T) {
 			updateValue2, err := tc.resourceAttrNameUpdateValue2()
 			assert.NoError(t, err)
 
-			resource.Test(t, resource.TestCase{
+			testAccTest(t, resource.TestCase{
 				PreCheck:                 func() { testAccPreCheck(t) },
 				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 				Steps: []resource.TestStep{
@@ -312,7 +370,7 @@ func TestAccResourceDefinition_S3_static_secrets(t *testing.T) {
 	var expectedSecretRefAfterUpdate string
 	id := fmt.Sprintf("s3-test-with-secrets-%d", time.Now().UnixNano())
 	t.Run("S3 static - secrets", func(t *testing.T) {
-		resource.Test(t, resource.TestCase{
+		testAccTest(t, resource.TestCase{
 			PreCheck:                 func() { testAccPreCheck(t) },
 			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 			Steps: []resource.TestStep{
@@ -358,7 +416,7 @@ func TestAccResourceDefinition_S3_static_secrets(t *testing.T) {
 					ResourceName:            "humanitec_resource_definition.s3_test_with_secrets",
 					ImportState:             true,
 					ImportStateVerify:       true,
-					ImportStateVerifyIgnore: []string{"driver_inputs.secrets_string", "force_delete"},
+					ImportStateVerifyIgnore: []string{"driver_inputs.secrets_string"},
 				},
 				// Update and Read testing
 				{
@@ -367,6 +425,20 @@ func TestAccResourceDefinition_S3_static_secrets(t *testing.T) {
 						resource.TestCheckResourceAttrPtr("humanitec_resource_definition.s3_test_with_secrets", "driver_inputs.secret_refs", &expectedSecretRefAfterUpdate),
 					),
 				},
+				// Switch from secrets_string to secret_refs values in place
+				{
+					Config: testAccResourceDefinitionS3taticResourceWithSecretRefValues(id, "accessKeyId3", "secretAccessKey3"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("humanitec_resource_definition.s3_test_with_secrets", "id", id),
+						resource.TestCheckNoResourceAttr("humanitec_resource_definition.s3_test_with_secrets", "driver_inputs.secrets_string"),
+						resource.TestCheckResourceAttr("humanitec_resource_definition.s3_test_with_secrets", "driver_inputs.secret_refs", `{"aws_access_key_id":{"value":"accessKeyId3"},"aws_secret_access_key":{"value":"secretAccessKey3"}}`),
+					),
+				},
+				// The version bump of the stored secrets doesn't cause a diff
+				{
+					Config:   testAccResourceDefinitionS3taticResourceWithSecretRefValues(id, "accessKeyId3", "secretAccessKey3"),
+					PlanOnly: true,
+				},
 				// Delete testing automatically occurs in TestCase
 			},
 		})
@@ -390,6 +462,40 @@ resource "humanitec_resource_definition" "s3_test" {
 `, id, region)
 }
 
+func testAccResourceDefinitionS3ResourceWithValues(id, region string) string {
+	return fmt.Sprintf(`
+resource "humanitec_resource_definition" "s3_test" {
+  id          = "%s"
+  name        = "s3-test"
+  type        = "s3"
+  driver_type = "humanitec/s3"
+
+  driver_inputs = {
+    values = {
+      region = "%s"
+    }
+  }
+}
+`, id, region)
+}
+
+func testAccResourceDefinitionS3ResourceWithYAMLValues(id, region string) string {
+	return fmt.Sprintf(`
+resource "humanitec_resource_definition" "s3_test" {
+  id          = "%s"
+  name        = "s3-test"
+  type        = "s3"
+  driver_type = "humanitec/s3"
+
+  driver_inputs = {
+    values_yaml = <<EOT
+region: %s
+EOT
+  }
+}
+`, id, region)
+}
+
 func testAccResourceDefinitionS3ResourceWithDifferentDriver(id, driver_type string) string {
 	return fmt.Sprintf(`
 resource "humanitec_resource_definition" "s3_test" {
@@ -498,6 +604,35 @@ resource "humanitec_resource_definition" "k8s_logging_test" {
 `, id, name)
 }
 
+func testAccResourceDefinitionTemplateManifestsResource(id, version string) string {
+	return fmt.Sprintf(`
+resource "humanitec_resource_definition" "template_test" {
+  id          = "%s"
+  name        = "template-test"
+  type        = "config"
+  driver_type = "humanitec/template"
+
+  driver_inputs = {
+    values_string = jsonencode({
+      templates = {
+        outputs = "version: %[2]s"
+      }
+    })
+    manifests = [
+      {
+        path    = "annotations.yaml"
+        content = <<EOT
+metadata:
+  annotations:
+    version: %[2]s
+EOT
+      }
+    ]
+  }
+}
+`, id, version)
+}
+
 func testAccResourceDefinitionProvisionResource(id, matchDependents string) string {
 	return fmt.Sprintf(`
 resource "humanitec_resource_definition" "provision_test" {
@@ -831,3 +966,465 @@ func TestMergeResourceDefinitionSecretRefResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretsStringHash(t *testing.T) {
+	assert := assert.New(t)
+
+	secretsString := types.StringValue(`{"password":"secret"}`)
+
+	stored, err := newSecretsStringHash(secretsString)
+	assert.NoError(err)
+	assert.NotContains(string(stored), "secret\"")
+
+	assert.True(secretsStringHashMatches(stored, secretsString))
+	assert.False(secretsStringHashMatches(stored, types.StringValue(`{"password":"changed"}`)))
+	assert.False(secretsStringHashMatches(stored, types.StringNull()))
+
+	// Hashes are salted, so the same secrets result in different values
+	other, err := newSecretsStringHash(secretsString)
+	assert.NoError(err)
+	assert.NotEqual(stored, other)
+
+	empty, err := newSecretsStringHash(types.StringNull())
+	assert.NoError(err)
+	assert.Nil(empty)
+}
+
+func TestPlanSecretsStringChangeUnknown(t *testing.T) {
+	ctx := context.Background()
+	r := &ResourceDefinitionResource{}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	assert.False(t, schemaResp.Diagnostics.HasError())
+
+	secretRefsPath := path.Root("driver_inputs").AtName("secret_refs")
+	nullValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+	newPlan := func(secretsString types.String, secretRefs types.String) tfsdk.Plan {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullValue}
+		assert.False(t, plan.SetAttribute(ctx, path.Root("id"), "s3-secrets").HasError())
+		assert.False(t, plan.SetAttribute(ctx, path.Root("driver_inputs").AtName("values_string"), `{"region":"us-east-1"}`).HasError())
+		assert.False(t, plan.SetAttribute(ctx, path.Root("driver_inputs").AtName("secrets_string"), secretsString).HasError())
+		assert.False(t, plan.SetAttribute(ctx, secretRefsPath, secretRefs).HasError())
+		return plan
+	}
+
+	storedRefs := types.StringValue(`{"aws_access_key_id":{"store":"humanitec","ref":"orgs/test/secret"}}`)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: newPlan(types.StringValue(`{"aws_access_key_id":"old"}`), storedRefs).Raw}
+
+	// e.g. secrets_string = jsonencode({ aws_access_key_id = aws_secretsmanager_secret_version.key.secret_string })
+	secretsString := types.StringUnknown()
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: newPlan(secretsString, types.StringNull()).Raw}
+	plan := newPlan(secretsString, storedRefs)
+
+	resp := &fwresource.ModifyPlanResponse{Plan: plan}
+	r.planSecretsStringChange(ctx, fwresource.ModifyPlanRequest{Config: config, Plan: plan, State: state}, secretsString, resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var secretRefs, valuesString types.String
+	assert.False(t, resp.Plan.GetAttribute(ctx, secretRefsPath, &secretRefs).HasError())
+	assert.True(t, secretRefs.IsUnknown(), "secret_refs are computed during apply")
+	assert.False(t, resp.Plan.GetAttribute(ctx, path.Root("driver_inputs").AtName("values_string"), &valuesString).HasError())
+	assert.Equal(t, `{"region":"us-east-1"}`, valuesString.ValueString(), "other driver inputs are kept")
+
+	// Configured secret_refs are left as they are
+	config = tfsdk.Config{Schema: schemaResp.Schema, Raw: newPlan(types.StringNull(), storedRefs).Raw}
+	plan = newPlan(types.StringNull(), storedRefs)
+	resp = &fwresource.ModifyPlanResponse{Plan: plan}
+	r.planSecretsStringChange(ctx, fwresource.ModifyPlanRequest{Config: config, Plan: plan, State: state}, secretsString, resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.False(t, resp.Plan.GetAttribute(ctx, secretRefsPath, &secretRefs).HasError())
+	assert.Equal(t, storedRefs, secretRefs)
+}
+
+func TestParseDriverAccount(t *testing.T) {
+	testCases := []struct {
+		name     string
+		response *string
+		parsed   types.String
+	}{
+		{name: "missing", response: nil, parsed: types.StringNull()},
+		{name: "empty", response: convert.ToPtr(""), parsed: types.StringNull()},
+		{name: "set", response: convert.ToPtr("gcp-account"), parsed: types.StringValue("gcp-account")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			parsed := parseDriverAccount(tc.response)
+			assert.Equal(tc.parsed, parsed)
+
+			// The parsed value round trips into an update that results in the same response
+			update := driverAccountUpdate(parsed)
+			if assert.NotNil(update) {
+				if tc.response == nil {
+					assert.Equal("", *update)
+				} else {
+					assert.Equal(*tc.response, *update)
+				}
+			}
+		})
+	}
+}
+
+func TestResourceDefinitionDriverAccount(t *testing.T) {
+	ctx := context.Background()
+	clearProviderEnv(t)
+
+	// The fake API keeps a single definition, like the API it returns an unset driver account as an empty string
+	var stored *string
+	var sent []*string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost || r.Method == http.MethodPut {
+			var body struct {
+				DriverAccount *string `json:"driver_account"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			sent = append(sent, body.DriverAccount)
+			stored = body.DriverAccount
+		}
+
+		res := map[string]any{
+			"id":          "def-id",
+			"name":        "def",
+			"type":        "postgres",
+			"driver_type": "humanitec/echo",
+			"org_id":      "test-org",
+			"created_by":  "user",
+			"created_at":  "2024-01-01T00:00:00Z",
+		}
+		if stored != nil {
+			res["driver_account"] = *stored
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+	defer srv.Close()
+
+	server := providerserver.NewProtocol6(New("test")())()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	assert.NoError(t, err)
+
+	dynamicValue := func(objectType tftypes.Object, value tftypes.Value) *tfprotov6.DynamicValue {
+		dv, err := tfprotov6.NewDynamicValue(objectType, value)
+		assert.NoError(t, err)
+		return &dv
+	}
+
+	providerType := schemaResp.Provider.ValueType().(tftypes.Object)
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: dynamicValue(providerType, testObjectValue(providerType, map[string]tftypes.Value{
+			"api_prefix":          tftypes.NewValue(tftypes.String, srv.URL),
+			"org_id":              tftypes.NewValue(tftypes.String, "test-org"),
+			"token":               tftypes.NewValue(tftypes.String, "TEST_TOKEN"),
+			"skip_api_validation": tftypes.NewValue(tftypes.Bool, true),
+		})),
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, configureResp.Diagnostics)
+
+	defSchema := schemaResp.ResourceSchemas["humanitec_resource_definition"]
+	defType := defSchema.ValueType().(tftypes.Object)
+	config := func(driverAccount tftypes.Value) tftypes.Value {
+		return testObjectValue(defType, map[string]tftypes.Value{
+			"id":             tftypes.NewValue(tftypes.String, "def-id"),
+			"name":           tftypes.NewValue(tftypes.String, "def"),
+			"type":           tftypes.NewValue(tftypes.String, "postgres"),
+			"driver_type":    tftypes.NewValue(tftypes.String, "humanitec/echo"),
+			"driver_account": driverAccount,
+		})
+	}
+	unset := tftypes.NewValue(tftypes.String, nil)
+	account := tftypes.NewValue(tftypes.String, "gcp-account")
+
+	driverAccount := func(state *tfprotov6.DynamicValue) tftypes.Value {
+		value, err := state.Unmarshal(defType)
+		assert.NoError(t, err)
+		var attributes map[string]tftypes.Value
+		assert.NoError(t, value.As(&attributes))
+		return attributes["driver_account"]
+	}
+
+	// apply plans and applies the config like Terraform does and returns the new state
+	apply := func(t *testing.T, prior *tfprotov6.DynamicValue, config tftypes.Value) *tfprotov6.DynamicValue {
+		if prior == nil {
+			prior = dynamicValue(defType, tftypes.NewValue(defType, nil))
+		}
+
+		// Terraform proposes the prior value of computed attributes missing in the config
+		priorValue, err := prior.Unmarshal(defType)
+		assert.NoError(t, err)
+		proposed := map[string]tftypes.Value{}
+		assert.NoError(t, config.As(&proposed))
+		if !priorValue.IsNull() {
+			var priorAttributes map[string]tftypes.Value
+			assert.NoError(t, priorValue.As(&priorAttributes))
+			for _, attribute := range defSchema.Block.Attributes {
+				if attribute.Computed && proposed[attribute.Name].IsNull() {
+					proposed[attribute.Name] = priorAttributes[attribute.Name]
+				}
+			}
+		}
+
+		planResp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+			TypeName:         "humanitec_resource_definition",
+			PriorState:       prior,
+			ProposedNewState: dynamicValue(defType, tftypes.NewValue(defType, proposed)),
+			Config:           dynamicValue(defType, config),
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, planResp.Diagnostics)
+
+		applyResp, err := server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+			TypeName:       "humanitec_resource_definition",
+			PriorState:     prior,
+			PlannedState:   planResp.PlannedState,
+			Config:         dynamicValue(defType, config),
+			PlannedPrivate: planResp.PlannedPrivate,
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, applyResp.Diagnostics)
+		return applyResp.NewState
+	}
+
+	t.Run("create", func(t *testing.T) {
+		for _, tc := range []struct {
+			name          string
+			driverAccount tftypes.Value
+			sent          *string
+		}{
+			{name: "unset", driverAccount: unset, sent: nil},
+			{name: "set", driverAccount: account, sent: convert.ToPtr("gcp-account")},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				stored, sent = nil, nil
+
+				state := apply(t, nil, config(tc.driverAccount))
+				assert.Equal(t, []*string{tc.sent}, sent)
+				assert.Equal(t, tc.driverAccount, driverAccount(state))
+			})
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		for _, tc := range []struct {
+			name string
+			from tftypes.Value
+			to   tftypes.Value
+			sent *string
+		}{
+			{name: "set", from: unset, to: account, sent: convert.ToPtr("gcp-account")},
+			{name: "unset", from: account, to: unset, sent: convert.ToPtr("")},
+			{name: "unchanged", from: account, to: account, sent: convert.ToPtr("gcp-account")},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				stored, sent = nil, nil
+
+				state := apply(t, apply(t, nil, config(tc.from)), config(tc.to))
+				assert.Equal(t, tc.sent, sent[len(sent)-1])
+				assert.Equal(t, tc.to, driverAccount(state))
+
+				// Applying the same config again keeps the driver account
+				next := apply(t, state, config(tc.to))
+				assert.Equal(t, tc.to, driverAccount(next))
+			})
+		}
+	})
+
+	t.Run("import", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			stored   *string
+			imported tftypes.Value
+		}{
+			{name: "missing", stored: nil, imported: unset},
+			{name: "empty", stored: convert.ToPtr(""), imported: unset},
+			{name: "set", stored: convert.ToPtr("gcp-account"), imported: account},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				stored = tc.stored
+
+				importResp, err := server.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{
+					TypeName: "humanitec_resource_definition",
+					ID:       "def-id",
+				})
+				assert.NoError(t, err)
+				assert.Empty(t, importResp.Diagnostics)
+				if !assert.Len(t, importResp.ImportedResources, 1) {
+					return
+				}
+
+				readResp, err := server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+					TypeName:     "humanitec_resource_definition",
+					CurrentState: importResp.ImportedResources[0].State,
+					Private:      importResp.ImportedResources[0].Private,
+				})
+				assert.NoError(t, err)
+				assert.Empty(t, readResp.Diagnostics)
+				assert.Equal(t, tc.imported, driverAccount(readResp.NewState))
+			})
+		}
+	})
+
+	t.Run("empty string", func(t *testing.T) {
+		resp, err := server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+			TypeName: "humanitec_resource_definition",
+			Config:   dynamicValue(defType, config(tftypes.NewValue(tftypes.String, ""))),
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, resp.Diagnostics, 1) {
+			assert.Equal(t, tftypes.NewAttributePath().WithAttributeName("driver_account"), resp.Diagnostics[0].Attribute)
+		}
+	})
+}
+
+func TestDriverInputsUnchanged(t *testing.T) {
+	inputs := func(valuesString, secretsString string, secretRefs types.String) *DefinitionResourceDriverInputsModel {
+		return &DefinitionResourceDriverInputsModel{
+			Values:        types.DynamicNull(),
+			ValuesString:  types.StringValue(valuesString),
+			SecretsString: types.StringValue(secretsString),
+			SecretRefs:    secretRefs,
+		}
+	}
+	state := inputs(`{"region":"us-east-1"}`, `{"key":"secret"}`, types.StringValue(`{"key":{"ref":"path"}}`))
+
+	testCases := []struct {
+		name      string
+		plan      *DefinitionResourceDriverInputsModel
+		state     *DefinitionResourceDriverInputsModel
+		unchanged bool
+	}{
+		{name: "both unset", plan: nil, state: nil, unchanged: true},
+		{name: "added", plan: state, state: nil, unchanged: false},
+		{name: "removed", plan: nil, state: state, unchanged: false},
+		{name: "same", plan: inputs(`{"region":"us-east-1"}`, `{"key":"secret"}`, types.StringValue(`{"key":{"ref":"path"}}`)), state: state, unchanged: true},
+		{name: "unknown secret_refs", plan: inputs(`{"region":"us-east-1"}`, `{"key":"secret"}`, types.StringUnknown()), state: state, unchanged: true},
+		{name: "values changed", plan: inputs(`{"region":"eu-west-1"}`, `{"key":"secret"}`, types.StringUnknown()), state: state, unchanged: false},
+		{name: "secrets changed", plan: inputs(`{"region":"us-east-1"}`, `{"key":"other"}`, types.StringUnknown()), state: state, unchanged: false},
+		{name: "secret_refs changed", plan: inputs(`{"region":"us-east-1"}`, `{"key":"secret"}`, types.StringValue(`{"key":{"ref":"other"}}`)), state: state, unchanged: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.unchanged, driverInputsUnchanged(tc.plan, tc.state))
+		})
+	}
+}
+
+func TestTemplateManifests(t *testing.T) {
+	assert := assert.New(t)
+
+	manifests := []DefinitionResourceManifestModel{
+		{
+			Path:     types.StringValue("annotations.yaml"),
+			Location: types.StringNull(),
+			Content:  types.StringValue("metadata:\n  annotations:\n    id: \"{{ .id }}\"\n"),
+		},
+		{
+			Path:     types.StringValue("role.yaml"),
+			Location: types.StringValue("cluster"),
+			Content:  types.StringValue("kind: ClusterRole\n\nrules: []"),
+		},
+	}
+
+	rendered := renderTemplateManifests(manifests)
+	assert.Equal(`annotations.yaml:
+  location: namespace
+  data:
+    metadata:
+      annotations:
+        id: "{{ .id }}"
+role.yaml:
+  location: cluster
+  data:
+    kind: ClusterRole
+
+    rules: []
+`, rendered)
+
+	values := map[string]interface{}{"templates": map[string]interface{}{"outputs": "id: test"}}
+	assert.False(injectTemplateManifests(values, manifests).HasError())
+	assert.Equal(map[string]interface{}{"templates": map[string]interface{}{"outputs": "id: test", "manifests": rendered}}, values)
+
+	assert.Equal(map[string]interface{}{"templates": map[string]interface{}{"outputs": "id: test"}}, stripTemplateManifests(values, manifests))
+	assert.Equal(map[string]interface{}{}, stripTemplateManifests(map[string]interface{}{"templates": map[string]interface{}{"manifests": rendered}}, manifests))
+
+	drifted := map[string]interface{}{"templates": map[string]interface{}{"manifests": "changed"}}
+	assert.Equal(drifted, stripTemplateManifests(drifted, manifests), "changes outside of Terraform are kept")
+
+	assert.True(injectTemplateManifests(map[string]interface{}{"templates": map[string]interface{}{"manifests": "other"}}, manifests).HasError(), "conflicts with templates.manifests")
+	assert.True(injectTemplateManifests(map[string]interface{}{"templates": "invalid"}, manifests).HasError(), "templates must be an object")
+}
+
+func TestTemplateManifestContentValidator(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name        string
+		content     types.String
+		expectError bool
+	}{
+		{name: "object", content: types.StringValue("metadata:\n  name: \"{{ .id }}\"")},
+		{name: "unknown", content: types.StringUnknown()},
+		{name: "unquoted template expression", content: types.StringValue("metadata:\n  name: {{ .id }}"), expectError: true},
+		{name: "list", content: types.StringValue("- a\n- b"), expectError: true},
+		{name: "invalid", content: types.StringValue("a: b: c"), expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			templateManifestContentValidator{}.ValidateString(ctx, validator.StringRequest{Path: path.Root("content"), ConfigValue: tc.content}, resp)
+			assert.Equal(t, tc.expectError, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		})
+	}
+}
+
+func TestDriverInputsYAML(t *testing.T) {
+	assert := assert.New(t)
+
+	data := &DefinitionResourceModel{
+		DriverInputs: &DefinitionResourceDriverInputsModel{
+			Values:      types.DynamicNull(),
+			ValuesYAML:  types.StringValue("# region of the bucket\nregion: us-east-1\nreplicas: 2\n"),
+			SecretsYAML: types.StringValue("aws_access_key_id: key\n"),
+			SecretRefs:  types.StringUnknown(),
+		},
+	}
+
+	driverInputs, diags := driverInputsFromModel(data)
+	assert.False(diags.HasError(), "%v", diags)
+	assert.Equal(map[string]interface{}{"region": "us-east-1", "replicas": float64(2)}, *driverInputs.Values)
+	assert.Equal(map[string]interface{}{"aws_access_key_id": "key"}, *driverInputs.Secrets)
+
+	assert.False(parseResourceDefinitionValuesYAMLResponse(*driverInputs.Values, data).HasError())
+	assert.Equal("# region of the bucket\nregion: us-east-1\nreplicas: 2\n", data.DriverInputs.ValuesYAML.ValueString(), "equal values keep the configured YAML")
+
+	assert.False(parseResourceDefinitionValuesYAMLResponse(map[string]interface{}{"region": "us-east-2"}, data).HasError())
+	assert.Equal("region: us-east-2\n", data.DriverInputs.ValuesYAML.ValueString())
+}
+
+func TestYAMLObjectValidator(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name        string
+		value       types.String
+		expectError bool
+	}{
+		{name: "object", value: types.StringValue("region: us-east-1")},
+		{name: "null", value: types.StringNull()},
+		{name: "list", value: types.StringValue("- a\n- b"), expectError: true},
+		{name: "invalid", value: types.StringValue("a: b: c"), expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			yamlObjectValidator{}.ValidateString(ctx, validator.StringRequest{Path: path.Root("values_yaml"), ConfigValue: tc.value}, resp)
+			assert.Equal(t, tc.expectError, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/hashcode"
+	"github.com/humanitec/terraform-provider-humanitec/internal/pagination"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ResourceDefinitionsDataSource{}
+
+func NewResourceDefinitionsDataSource() datasource.DataSource {
+	return &ResourceDefinitionsDataSource{}
+}
+
+// ResourceDefinitionsDataSource defines the data source implementation.
+type ResourceDefinitionsDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// ResourceDefinitionsDataSourceModel describes the data source data model.
+type ResourceDefinitionsDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Filter      types.Object `tfsdk:"filter"`
+	Definitions types.List   `tfsdk:"definitions"`
+}
+
+type ResourceDefinitionsFilterDataSourceModel struct {
+	Type       types.String `tfsdk:"type"`
+	DriverType types.String `tfsdk:"driver_type"`
+	AppID      types.String `tfsdk:"app_id"`
+	EnvID      types.String `tfsdk:"env_id"`
+	EnvType    types.String `tfsdk:"env_type"`
+}
+
+// ResourceDefinitionDataSourceModel describes a single resource definition.
+type ResourceDefinitionDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+	DriverType    types.String `tfsdk:"driver_type"`
+	DriverAccount types.String `tfsdk:"driver_account"`
+}
+
+var resourceDefinitionAttrTypes = map[string]attr.Type{
+	"id":             types.StringType,
+	"name":           types.StringType,
+	"type":           types.StringType,
+	"driver_type":    types.StringType,
+	"driver_account": types.StringType,
+}
+
+func (d *ResourceDefinitionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_definitions"
+}
+
+func (d *ResourceDefinitionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource Definitions of the organization, e.g. to report which definitions can be used in an Environment.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The Resource Type, e.g. `postgres`.",
+						Optional:            true,
+					},
+					"driver_type": schema.StringAttribute{
+						MarkdownDescription: "The Driver Type, e.g. `humanitec/postgres-cloudsql-static`.",
+						Optional:            true,
+					},
+					"app_id": schema.StringAttribute{
+						MarkdownDescription: "Only include definitions with matching criteria which can match resources of this Application, i.e. with `app_id` unset or equal to it.",
+						Optional:            true,
+					},
+					"env_id": schema.StringAttribute{
+						MarkdownDescription: "Only include definitions with matching criteria which can match resources of this Environment, i.e. with `env_id` unset or equal to it.",
+						Optional:            true,
+					},
+					"env_type": schema.StringAttribute{
+						MarkdownDescription: "Only include definitions with matching criteria which can match resources of Environments of this type, i.e. with `env_type` unset or equal to it.",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"definitions": schema.ListAttribute{
+				MarkdownDescription: "List of resource definitions with their `id`, `name`, `type`, `driver_type` and `driver_account`.",
+				ElementType: types.ObjectType{
+					AttrTypes: resourceDefinitionAttrTypes,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *ResourceDefinitionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *ResourceDefinitionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ResourceDefinitionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter ResourceDefinitionsFilterDataSourceModel
+	if !data.Filter.IsNull() {
+		resp.Diagnostics.Append(data.Filter.As(ctx, &filter, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	params := &client.ListResourceDefinitionsParams{ResType: filter.Type.ValueStringPointer()}
+	defs, err := pagination.All(ctx, func(ctx context.Context, editor pagination.RequestEditor) ([]client.ResourceDefinitionResponse, *http.Response, error) {
+		httpResp, err := d.client.ListResourceDefinitionsWithResponse(ctx, d.orgId, params, editor)
+		if err != nil {
+			return nil, nil, err
+		}
+		if httpResp.StatusCode() != 200 {
+			return nil, nil, fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+		}
+		if httpResp.JSON200 == nil {
+			return nil, httpResp.HTTPResponse, nil
+		}
+		return *httpResp.JSON200, httpResp.HTTPResponse, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list resource definitions, got error: %s", err))
+		return
+	}
+
+	defIds := []string{}
+	definitions := []basetypes.ObjectValue{}
+	for _, def := range defs {
+		if !resourceDefinitionMatches(def, filter) {
+			continue
+		}
+
+		definition, diags := types.ObjectValueFrom(ctx, resourceDefinitionAttrTypes, &ResourceDefinitionDataSourceModel{
+			ID:            types.StringValue(def.Id),
+			Name:          types.StringValue(def.Name),
+			Type:          types.StringValue(def.Type),
+			DriverType:    types.StringValue(def.DriverType),
+			DriverAccount: parseDriverAccount(def.DriverAccount),
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		defIds = append(defIds, def.Id)
+		definitions = append(definitions, definition)
+	}
+
+	definitionsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: resourceDefinitionAttrTypes}, definitions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Definitions = definitionsList
+	data.ID = types.StringValue(hashcode.Strings(defIds))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// resourceDefinitionMatches reports whether the resource definition matches all attributes set in the filter. With
+// app_id, env_id or env_type set, at least one of its criteria has to be able to match resources in that context.
+func resourceDefinitionMatches(def client.ResourceDefinitionResponse, filter ResourceDefinitionsFilterDataSourceModel) bool {
+	resType := filter.Type.ValueStringPointer()
+	driverType := filter.DriverType.ValueStringPointer()
+
+	if resType != nil && def.Type != *resType {
+		return false
+	}
+	if driverType != nil && def.DriverType != *driverType {
+		return false
+	}
+
+	if filter.AppID.IsNull() && filter.EnvID.IsNull() && filter.EnvType.IsNull() {
+		return true
+	}
+	if def.Criteria == nil {
+		return false
+	}
+
+	// criteriaFieldMatches reports whether a criteria field can match the filter value, unset fields match any value
+	criteriaFieldMatches := func(criteria *string, value types.String) bool {
+		return value.IsNull() || criteria == nil || *criteria == "" || *criteria == value.ValueString()
+	}
+	for _, c := range *def.Criteria {
+		if criteriaFieldMatches(c.AppId, filter.AppID) && criteriaFieldMatches(c.EnvId, filter.EnvID) && criteriaFieldMatches(c.EnvType, filter.EnvType) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/humanitec/humanitec-go-autogen/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccResourceDefinitionsDataSource(t *testing.T) {
+	id := fmt.Sprintf("defs-test-%d", time.Now().UnixNano())
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDefinitionsDataSourceConfig(id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.humanitec_resource_definitions.test", "definitions.#", "1"),
+					resource.TestCheckResourceAttr("data.humanitec_resource_definitions.test", "definitions.0.id", id),
+					resource.TestCheckResourceAttr("data.humanitec_resource_definitions.test", "definitions.0.driver_type", "humanitec/echo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDefinitionsDataSourceConfig(id string) string {
+	return fmt.Sprintf(`
+resource "humanitec_resource_definition" "test" {
+	id          = "%s"
+	name        = "%s"
+	type        = "dns"
+	driver_type = "humanitec/echo"
+
+	driver_inputs = {
+		values_string = jsonencode({
+			host = "example.com"
+		})
+	}
+}
+
+resource "humanitec_resource_definition_criteria" "test" {
+	resource_definition_id = humanitec_resource_definition.test.id
+	app_id                 = "%s"
+}
+
+data "humanitec_resource_definitions" "test" {
+	filter = {
+		type   = "dns"
+		app_id = humanitec_resource_definition_criteria.test.app_id
+	}
+}
+`, id, id, id)
+}
+
+func TestResourceDefinitionMatches(t *testing.T) {
+	appID := "my-app"
+	def := client.ResourceDefinitionResponse{
+		Id:         "postgres-def",
+		Type:       "postgres",
+		DriverType: "humanitec/postgres",
+		Criteria: &[]client.MatchingCriteriaResponse{
+			{Id: "criteria-1", AppId: &appID},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		filter   ResourceDefinitionsFilterDataSourceModel
+		expected bool
+	}{
+		{
+			name:     "empty filter",
+			filter:   ResourceDefinitionsFilterDataSourceModel{},
+			expected: true,
+		},
+		{
+			name: "all matching",
+			filter: ResourceDefinitionsFilterDataSourceModel{
+				Type:       types.StringValue("postgres"),
+				DriverType: types.StringValue("humanitec/postgres"),
+				AppID:      types.StringValue("my-app"),
+				EnvID:      types.StringValue("development"),
+			},
+			expected: true,
+		},
+		{
+			name:     "other driver type",
+			filter:   ResourceDefinitionsFilterDataSourceModel{DriverType: types.StringValue("humanitec/echo")},
+			expected: false,
+		},
+		{
+			name:     "other app",
+			filter:   ResourceDefinitionsFilterDataSourceModel{AppID: types.StringValue("other-app")},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resourceDefinitionMatches(def, tc.filter))
+		})
+	}
+
+	assert.False(t, resourceDefinitionMatches(client.ResourceDefinitionResponse{Type: "postgres"}, ResourceDefinitionsFilterDataSourceModel{EnvType: types.StringValue("development")}), "no criteria")
+}
@@ -2,16 +2,23 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"github.com/humanitec/humanitec-go-autogen"
 	"github.com/humanitec/humanitec-go-autogen/client"
@@ -20,6 +27,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &ResourceEnvironment{}
 var _ resource.ResourceWithImportState = &ResourceEnvironment{}
+var _ resource.ResourceWithModifyPlan = &ResourceEnvironment{}
 
 func NewResourceEnvironment() resource.Resource {
 	return &ResourceEnvironment{}
@@ -29,6 +37,7 @@ func NewResourceEnvironment() resource.Resource {
 type ResourceEnvironment struct {
 	client *humanitec.Client
 	orgID  string
+	data   *HumanitecData
 }
 
 type EnvironmentModel struct {
@@ -37,6 +46,13 @@ type EnvironmentModel struct {
 	Name         types.String `tfsdk:"name"`
 	Type         types.String `tfsdk:"type"`
 	FromDeployID types.String `tfsdk:"from_deploy_id"`
+	FromEnvID    types.String `tfsdk:"from_env_id"`
+	InitialDelta types.String `tfsdk:"initial_delta"`
+	ExpiresAt    types.String `tfsdk:"expires_at"`
+	WaitForReady types.Bool   `tfsdk:"wait_for_ready"`
+	CreatedBy    types.String `tfsdk:"created_by"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ResourceEnvironment) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -80,29 +96,73 @@ func (r *ResourceEnvironment) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"from_env_id": schema.StringAttribute{
+				MarkdownDescription: "Defines an existing Environment of the same Application the new Environment will be based on. The latest successful Deployment of this Environment is used as `from_deploy_id` when the Environment is created.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("from_deploy_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"initial_delta": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded Deployment Delta which is created and deployed to the Environment right after it has been created, e.g. to bootstrap the workloads of ephemeral environments. The `metadata.env_id` of the Delta is set to the Environment. Changing it re-creates the Environment.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("RFC3339 timestamp after which the Environment is considered stale, e.g. for ephemeral preview environments. It is recorded in the `%s` shared value of the Environment and expired Environments are listed by the `humanitec_expired_environments` data source. The Environment is not deleted automatically.", environmentExpiresAtKey),
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				MarkdownDescription: "Wait on create until the latest Deployment of the Environment succeeded, its namespace exists and none of its Active Resources are pending, so that resources targeting the namespace don't race its creation. Requires the Environment to be deployed, e.g. through `from_env_id`, `from_deploy_id` or `initial_delta`. Bounded by the create timeout. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user who created the Environment.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 func (r *ResourceEnvironment) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
 		return
 	}
 
-	resdata, ok := req.ProviderData.(*HumanitecData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
 		return
 	}
 
 	r.client = resdata.Client
 	r.orgID = resdata.OrgID
+	r.data = resdata
+}
+
+func (r *ResourceEnvironment) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.data.validateAppReference(ctx, req, resp)
+	r.data.validateIDConvention(ctx, req, resp, "environment")
 }
 
 func (r *ResourceEnvironment) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -115,14 +175,40 @@ func (r *ResourceEnvironment) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	appID := data.AppID.ValueString()
 
+	fromDeployID := data.FromDeployID.ValueStringPointer()
+	if fromEnvID := data.FromEnvID.ValueString(); fromEnvID != "" {
+		listDeploymentsResp, err := r.client.ListDeploymentsWithResponse(ctx, r.orgID, appID, fromEnvID, &client.ListDeploymentsParams{})
+		if err != nil {
+			resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list deployments of environment %s, got error: %s", fromEnvID, err))
+			return
+		}
+		if listDeploymentsResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list deployments of environment %s, unexpected status code: %d, body: %s", fromEnvID, listDeploymentsResp.StatusCode(), listDeploymentsResp.Body))
+			return
+		}
+
+		deployment, ok := latestSucceededDeployment(listDeploymentsResp.JSON200)
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("from_env_id"), HUM_INPUT_ERR, fmt.Sprintf("Environment %s has no successful deployment to create the environment from", fromEnvID))
+			return
+		}
+		fromDeployID = &deployment.Id
+	}
+
 	var environment *client.EnvironmentResponse
 	createEnvironmentResp, err := r.client.CreateEnvironmentWithResponse(ctx, r.orgID, appID, client.EnvironmentDefinitionRequest{
 		Id:           data.ID.ValueString(),
 		Name:         data.Name.ValueString(),
 		Type:         data.Type.ValueStringPointer(),
-		FromDeployId: data.FromDeployID.ValueStringPointer(),
+		FromDeployId: fromDeployID,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to create environment, got error: %s", err))
@@ -144,8 +230,142 @@ func (r *ResourceEnvironment) Create(ctx context.Context, req resource.CreateReq
 
 	parseEnvironmentResponse(appID, environment, data)
 
-	// Save data into Terraform state
+	if err := setEnvironmentExpiry(ctx, r.client, r.orgID, appID, data.ID.ValueString(), data.ExpiresAt.ValueStringPointer(), nil); err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to record environment expiry, got error: %s", err))
+		data.ExpiresAt = types.StringNull()
+	}
+
+	// Save the environment before deploying the initial delta so that a failed deployment doesn't leave it untracked
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.InitialDelta.IsNull() {
+		resp.Diagnostics.Append(r.deployInitialDelta(ctx, appID, data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if data.WaitForReady.ValueBool() {
+		if err := r.waitForReady(ctx, appID, data.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Environment %s was created but didn't become ready, got error: %s", data.ID.ValueString(), err))
+		}
+	}
+}
+
+// deployInitialDelta creates the initial_delta of the environment and deploys it.
+func (r *ResourceEnvironment) deployInitialDelta(ctx context.Context, appID string, data *EnvironmentModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	deltaRequest, err := toInitialDeltaRequest(data.ID.ValueString(), data.InitialDelta.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("initial_delta"), HUM_INPUT_ERR, fmt.Sprintf("Failed to unmarshal initial_delta: %s", err))
+		return diags
+	}
+
+	createDeltaResp, err := r.client.PostOrgsOrgIdAppsAppIdDeltasWithResponse(ctx, r.orgID, appID, *deltaRequest)
+	if err != nil {
+		diags.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to create initial delta, got error: %s", err))
+		return diags
+	}
+	if createDeltaResp.StatusCode() != http.StatusOK {
+		diags.AddError(HUM_API_ERR, fmt.Sprintf("Unable to create initial delta, unexpected status code: %d, body: %s", createDeltaResp.StatusCode(), createDeltaResp.Body))
+		return diags
+	}
+
+	deltaID, err := createdDeltaID(createDeltaResp.Body)
+	if err != nil {
+		diags.AddError(HUM_API_ERR, fmt.Sprintf("Unable to read the id of the initial delta, got error: %s", err))
+		return diags
+	}
+
+	comment := "Initial delta deployed by Terraform"
+	createDeploymentResp, err := r.client.CreateDeploymentWithResponse(ctx, r.orgID, appID, data.ID.ValueString(), client.DeploymentRequest{
+		DeltaId: &deltaID,
+		Comment: &comment,
+	})
+	if err != nil {
+		diags.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to deploy initial delta, got error: %s", err))
+		return diags
+	}
+	if createDeploymentResp.StatusCode() != http.StatusCreated {
+		diags.AddError(HUM_API_ERR, fmt.Sprintf("Unable to deploy initial delta, unexpected status code: %d, body: %s", createDeploymentResp.StatusCode(), createDeploymentResp.Body))
+	}
+
+	return diags
+}
+
+// waitForReady polls the deployments and active resources of the environment until environmentReadiness reports it ready
+// or the deadline of ctx is exceeded.
+func (r *ResourceEnvironment) waitForReady(ctx context.Context, appID, envID string) error {
+	deadline, _ := ctx.Deadline()
+	return retry.RetryContext(ctx, time.Until(deadline), func() *retry.RetryError {
+		deploymentsResp, err := r.client.ListDeploymentsWithResponse(ctx, r.orgID, appID, envID, &client.ListDeploymentsParams{})
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if deploymentsResp.StatusCode() != http.StatusOK {
+			return retry.NonRetryableError(fmt.Errorf("unexpected status code listing deployments: %d, body: %s", deploymentsResp.StatusCode(), deploymentsResp.Body))
+		}
+
+		resourcesResp, err := r.client.ListActiveResourcesWithResponse(ctx, r.orgID, appID, envID)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if resourcesResp.StatusCode() != http.StatusOK {
+			return retry.NonRetryableError(fmt.Errorf("unexpected status code listing active resources: %d, body: %s", resourcesResp.StatusCode(), resourcesResp.Body))
+		}
+
+		pending, err := environmentReadiness(deploymentsResp.JSON200, resourcesResp.JSON200)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if pending != "" {
+			return retry.RetryableError(fmt.Errorf("environment %s isn't ready yet, %s", envID, pending))
+		}
+		return nil
+	})
+}
+
+// environmentReadiness returns why an environment isn't ready yet, or an error if it won't become ready.
+// An environment is ready once its latest deployment succeeded, its namespace exists and none of its active resources are pending.
+func environmentReadiness(deployments *[]client.DeploymentResponse, resources *[]client.ActiveResourceResponse) (string, error) {
+	if deployments == nil || len(*deployments) == 0 {
+		return "it hasn't been deployed", nil
+	}
+
+	latest := (*deployments)[0]
+	for _, deployment := range *deployments {
+		if deployment.CreatedAt.After(latest.CreatedAt) {
+			latest = deployment
+		}
+	}
+	switch latest.Status {
+	case "succeeded":
+	case "failed":
+		return "", fmt.Errorf("deployment %s failed", latest.Id)
+	default:
+		return fmt.Sprintf("deployment %s is %s", latest.Id, latest.Status), nil
+	}
+
+	namespaceExists := false
+	if resources != nil {
+		for _, res := range *resources {
+			if res.Status == "pending" {
+				return fmt.Sprintf("active resource %s is pending", res.GuResId), nil
+			}
+			if res.Type == "k8s-namespace" {
+				namespaceExists = true
+			}
+		}
+	}
+	if !namespaceExists {
+		return "its namespace doesn't exist", nil
+	}
+
+	return "", nil
 }
 
 func (r *ResourceEnvironment) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -158,6 +378,12 @@ func (r *ResourceEnvironment) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	appID := data.AppID.ValueString()
 	id := data.ID.ValueString()
 
@@ -181,6 +407,18 @@ func (r *ResourceEnvironment) Read(ctx context.Context, req resource.ReadRequest
 
 	parseEnvironmentResponse(appID, environment, data)
 
+	expiresAt, err := getEnvironmentExpiry(ctx, r.client, r.orgID, appID, id)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to read environment expiry, got error: %s", err))
+		return
+	}
+	data.ExpiresAt = types.StringPointerValue(expiresAt)
+
+	// wait_for_ready is client-only, fall back to its default after an import
+	if data.WaitForReady.IsNull() {
+		data.WaitForReady = types.BoolValue(false)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -195,6 +433,12 @@ func (r *ResourceEnvironment) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	appID := state.AppID.ValueString()
 	id := state.ID.ValueString()
 
@@ -225,6 +469,11 @@ func (r *ResourceEnvironment) Update(ctx context.Context, req resource.UpdateReq
 
 	parseEnvironmentResponse(appID, environment, data)
 
+	if err := setEnvironmentExpiry(ctx, r.client, r.orgID, appID, id, data.ExpiresAt.ValueStringPointer(), state.ExpiresAt.ValueStringPointer()); err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to record environment expiry, got error: %s", err))
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -238,6 +487,12 @@ func (r *ResourceEnvironment) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	appID := data.AppID.ValueString()
 	id := data.ID.ValueString()
 
@@ -262,14 +517,21 @@ func (r *ResourceEnvironment) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *ResourceEnvironment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
+	idParts, err := importIDParts(req.ID, r.orgID, "apps", "envs")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Unable to parse import URL %q: %s", req.ID, err),
+		)
+		return
+	}
 
 	// ensure idParts elements are not empty
 	for _, idPart := range idParts {
 		if idPart == "" {
 			resp.Diagnostics.AddError(
 				"Unexpected Import Identifier",
-				fmt.Sprintf("Expected import identifier with format: app_id/env_id. Got: %q", req.ID),
+				fmt.Sprintf("Expected import identifier with format: app_id/env_id or a Humanitec console URL. Got: %q", req.ID),
 			)
 			return
 		}
@@ -281,7 +543,7 @@ func (r *ResourceEnvironment) ImportState(ctx context.Context, req resource.Impo
 	} else {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: app_id/env_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: app_id/env_id or a Humanitec console URL. Got: %q", req.ID),
 		)
 		return
 	}
@@ -292,4 +554,81 @@ func parseEnvironmentResponse(appID string, res *client.EnvironmentResponse, dat
 	data.ID = types.StringValue(res.Id)
 	data.Name = types.StringValue(res.Name)
 	data.Type = types.StringValue(res.Type)
+	data.CreatedBy = types.StringValue(res.CreatedBy)
+}
+
+// rfc3339Validator ensures a string is a RFC3339 timestamp.
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v rfc3339Validator) MarkdownDescription(_ context.Context) string {
+	return "value must be a RFC3339 timestamp, e.g. 2024-01-31T18:00:00Z"
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, HUM_INPUT_ERR,
+			fmt.Sprintf("%q is not a RFC3339 timestamp: %s", req.ConfigValue.ValueString(), err))
+	}
+}
+
+// toInitialDeltaRequest decodes the JSON encoded delta and targets it at the environment.
+func toInitialDeltaRequest(envID, content string) (*client.DeltaRequest, error) {
+	var delta client.DeltaRequest
+	if err := json.Unmarshal([]byte(content), &delta); err != nil {
+		return nil, err
+	}
+
+	if delta.Metadata == nil {
+		delta.Metadata = &client.DeltaMetadataRequest{}
+	}
+	delta.Metadata.EnvId = &envID
+
+	return &delta, nil
+}
+
+// createdDeltaID returns the id of a created delta, the API responds with either the delta or only its id.
+func createdDeltaID(body []byte) (string, error) {
+	var id string
+	if err := json.Unmarshal(body, &id); err == nil {
+		return id, nil
+	}
+
+	var delta client.DeltaResponse
+	if err := json.Unmarshal(body, &delta); err != nil {
+		return "", err
+	}
+	if delta.Id == "" {
+		return "", fmt.Errorf("no delta id in response: %s", body)
+	}
+	return delta.Id, nil
+}
+
+// latestSucceededDeployment returns the most recently created deployment with status succeeded.
+func latestSucceededDeployment(deployments *[]client.DeploymentResponse) (client.DeploymentResponse, bool) {
+	var latest client.DeploymentResponse
+	found := false
+
+	if deployments == nil {
+		return latest, found
+	}
+
+	for _, deployment := range *deployments {
+		if deployment.Status != "succeeded" {
+			continue
+		}
+		if !found || deployment.CreatedAt.After(latest.CreatedAt) {
+			latest = deployment
+			found = true
+		}
+	}
+
+	return latest, found
 }
@@ -7,6 +7,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/humanitec/humanitec-go-autogen/client"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccResourceEnvironment(t *testing.T) {
@@ -16,7 +18,7 @@ func TestAccResourceEnvironment(t *testing.T) {
 	updatedName := "New Env Name"
 	envType := "development"
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -27,6 +29,7 @@ func TestAccResourceEnvironment(t *testing.T) {
 					resource.TestCheckResourceAttr("humanitec_environment.env_test", "app_id", appID),
 					resource.TestCheckResourceAttr("humanitec_environment.env_test", "id", id),
 					resource.TestCheckResourceAttr("humanitec_environment.env_test", "name", name),
+					resource.TestCheckResourceAttrSet("humanitec_environment.env_test", "created_by"),
 					resource.TestCheckResourceAttr("humanitec_environment.env_test", "type", envType),
 				),
 			},
@@ -81,3 +84,92 @@ func testAccCreateResourceEnvironment(appID, id, name, envType, fromDeployID str
 	}
 `, appID, id, name, envType, fromDeployIDLine)
 }
+
+func TestLatestSucceededDeployment(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+
+	deployments := []client.DeploymentResponse{
+		{Id: "failed-newest", Status: "failed", CreatedAt: now},
+		{Id: "succeeded-new", Status: "succeeded", CreatedAt: now.Add(-time.Minute)},
+		{Id: "succeeded-old", Status: "succeeded", CreatedAt: now.Add(-time.Hour)},
+	}
+
+	deployment, ok := latestSucceededDeployment(&deployments)
+	assert.True(ok)
+	assert.Equal("succeeded-new", deployment.Id)
+
+	_, ok = latestSucceededDeployment(&[]client.DeploymentResponse{{Id: "pending", Status: "pending"}})
+	assert.False(ok)
+
+	_, ok = latestSucceededDeployment(nil)
+	assert.False(ok)
+}
+
+func TestEnvironmentReadiness(t *testing.T) {
+	now := time.Now()
+	succeeded := []client.DeploymentResponse{
+		{Id: "failed-old", Status: "failed", CreatedAt: now.Add(-time.Hour)},
+		{Id: "succeeded-new", Status: "succeeded", CreatedAt: now},
+	}
+	namespace := client.ActiveResourceResponse{GuResId: "namespace", Type: "k8s-namespace", Status: "active"}
+
+	tests := []struct {
+		name        string
+		deployments *[]client.DeploymentResponse
+		resources   *[]client.ActiveResourceResponse
+		pending     string
+		err         string
+	}{
+		{name: "not deployed", deployments: nil, pending: "it hasn't been deployed"},
+		{name: "deployment in progress", deployments: &[]client.DeploymentResponse{{Id: "d1", Status: "in progress", CreatedAt: now}}, pending: "deployment d1 is in progress"},
+		{name: "deployment failed", deployments: &[]client.DeploymentResponse{{Id: "d1", Status: "failed", CreatedAt: now}}, err: "deployment d1 failed"},
+		{name: "no namespace", deployments: &succeeded, resources: &[]client.ActiveResourceResponse{}, pending: "its namespace doesn't exist"},
+		{name: "pending resource", deployments: &succeeded, resources: &[]client.ActiveResourceResponse{namespace, {GuResId: "db", Type: "postgres", Status: "pending"}}, pending: "active resource db is pending"},
+		{name: "ready", deployments: &succeeded, resources: &[]client.ActiveResourceResponse{namespace}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pending, err := environmentReadiness(tt.deployments, tt.resources)
+			assert.Equal(t, tt.pending, pending)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestToInitialDeltaRequest(t *testing.T) {
+	assert := assert.New(t)
+
+	delta, err := toInitialDeltaRequest("pr-42", `{"metadata": {"name": "bootstrap", "env_id": "development"}, "modules": {"add": {"my-module": {"spec": {}}}}}`)
+	assert.NoError(err)
+	assert.Equal("pr-42", *delta.Metadata.EnvId)
+	assert.Equal("bootstrap", *delta.Metadata.Name)
+	assert.NotNil(delta.Modules)
+
+	delta, err = toInitialDeltaRequest("pr-42", `{}`)
+	assert.NoError(err)
+	assert.Equal("pr-42", *delta.Metadata.EnvId)
+
+	_, err = toInitialDeltaRequest("pr-42", `not json`)
+	assert.Error(err)
+}
+
+func TestCreatedDeltaID(t *testing.T) {
+	assert := assert.New(t)
+
+	id, err := createdDeltaID([]byte(`"0123456789abcdef"`))
+	assert.NoError(err)
+	assert.Equal("0123456789abcdef", id)
+
+	id, err = createdDeltaID([]byte(`{"id": "0123456789abcdef", "metadata": {"env_id": "pr-42"}}`))
+	assert.NoError(err)
+	assert.Equal("0123456789abcdef", id)
+
+	_, err = createdDeltaID([]byte(`{}`))
+	assert.Error(err)
+}
@@ -3,10 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +21,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &ResourceEnvironmentType{}
 var _ resource.ResourceWithImportState = &ResourceEnvironmentType{}
+var _ resource.ResourceWithModifyPlan = &ResourceEnvironmentType{}
 
 func NewResourceEnvironmentType() resource.Resource {
 	return &ResourceEnvironmentType{}
@@ -31,8 +35,17 @@ type ResourceEnvironmentType struct {
 
 // EnvironmentTypeModel describes the app data model.
 type EnvironmentTypeModel struct {
-	ID          types.String `tfsdk:"id"`
-	Description types.String `tfsdk:"description"`
+	ID                 types.String `tfsdk:"id"`
+	Description        types.String `tfsdk:"description"`
+	Builtin            types.Bool   `tfsdk:"builtin"`
+	AllowBuiltinDelete types.Bool   `tfsdk:"allow_builtin_delete"`
+}
+
+// builtinEnvironmentTypes are the environment types every organization starts with, Applications expect them to exist.
+var builtinEnvironmentTypes = []string{"development", "staging", "production"}
+
+func isBuiltinEnvironmentType(id string) bool {
+	return slices.Contains(builtinEnvironmentTypes, id)
 }
 
 func (r *ResourceEnvironmentType) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -55,24 +68,32 @@ func (r *ResourceEnvironmentType) Schema(ctx context.Context, req resource.Schem
 				MarkdownDescription: "A Human-readable description of the Environment Type",
 				Optional:            true,
 			},
+			"builtin": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Environment Type is one of the built-in `development`, `staging` or `production` types, which are only deleted if `allow_builtin_delete` is set.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_builtin_delete": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, allows deleting a built-in Environment Type, which breaks every Application of the organization relying on it. Has to be applied before the resource is destroyed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
 
 func (r *ResourceEnvironmentType) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
 		return
 	}
 
-	resdata, ok := req.ProviderData.(*HumanitecData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
 		return
 	}
 
@@ -83,6 +104,12 @@ func (r *ResourceEnvironmentType) Configure(ctx context.Context, req resource.Co
 func parseEnvironmentTypeResponse(res *client.EnvironmentTypeResponse, data *EnvironmentTypeModel) {
 	data.ID = types.StringValue(res.Id)
 	data.Description = types.StringValue(res.Description)
+	data.Builtin = types.BoolValue(isBuiltinEnvironmentType(res.Id))
+
+	// Not returned by the API, e.g. after an import
+	if data.AllowBuiltinDelete.IsNull() {
+		data.AllowBuiltinDelete = types.BoolValue(false)
+	}
 }
 
 func (r *ResourceEnvironmentType) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -181,6 +208,25 @@ func (r *ResourceEnvironmentType) Update(ctx context.Context, req resource.Updat
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// ModifyPlan refuses to plan the deletion of a built-in environment type unless allow_builtin_delete is set in the state.
+func (r *ResourceEnvironmentType) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only destroy plans
+	if !req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var data *EnvironmentTypeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.ID.ValueString()
+	if isBuiltinEnvironmentType(id) && !data.AllowBuiltinDelete.ValueBool() {
+		resp.Diagnostics.AddError(HUM_INPUT_ERR, fmt.Sprintf("Environment type %s is built-in and every Application of the organization may rely on it. Set allow_builtin_delete to true and apply before deleting it, or remove it from the Terraform state with terraform state rm to stop managing it.", id))
+	}
+}
+
 func (r *ResourceEnvironmentType) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data *EnvironmentTypeModel
 
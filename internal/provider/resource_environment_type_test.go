@@ -1,17 +1,23 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccResourceEnvironmentType(t *testing.T) {
 	id := fmt.Sprintf("qa-env-%d", time.Now().UnixNano())
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -21,6 +27,7 @@ func TestAccResourceEnvironmentType(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("humanitec_environment_type.qa", "id", id),
 					resource.TestCheckResourceAttr("humanitec_environment_type.qa", "description", "Primary QA env"),
+					resource.TestCheckResourceAttr("humanitec_environment_type.qa", "builtin", "false"),
 				),
 			},
 			// ImportState testing
@@ -51,3 +58,57 @@ resource "humanitec_environment_type" "qa" {
 }
 `, id, description)
 }
+
+func TestResourceEnvironmentTypeModifyPlanBuiltin(t *testing.T) {
+	ctx := context.Background()
+	r := &ResourceEnvironmentType{}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	assert.False(t, schemaResp.Diagnostics.HasError())
+
+	emptyValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	tests := []struct {
+		name               string
+		id                 string
+		allowBuiltinDelete bool
+		destroy            bool
+		expectedError      bool
+	}{
+		{name: "destroy built-in", id: "development", destroy: true, expectedError: true},
+		{name: "destroy allowed built-in", id: "development", allowBuiltinDelete: true, destroy: true},
+		{name: "destroy custom", id: "qa", destroy: true},
+		{name: "update built-in", id: "development"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := &EnvironmentTypeModel{
+				ID:                 types.StringValue(tt.id),
+				Description:        types.StringValue("Environment type"),
+				Builtin:            types.BoolValue(isBuiltinEnvironmentType(tt.id)),
+				AllowBuiltinDelete: types.BoolValue(tt.allowBuiltinDelete),
+			}
+
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: emptyValue}
+			assert.False(t, state.Set(ctx, model).HasError())
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: emptyValue}
+			if !tt.destroy {
+				assert.False(t, plan.Set(ctx, model).HasError())
+			}
+
+			// Refused at plan time, before any API call
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+			assert.Equal(t, tt.expectedError, resp.Diagnostics.HasError(), resp.Diagnostics)
+			if tt.expectedError {
+				assert.Contains(t, resp.Diagnostics[0].Detail(), "Environment type development is built-in")
+			}
+		})
+	}
+
+	assert.True(t, isBuiltinEnvironmentType("production"))
+	assert.False(t, isBuiltinEnvironmentType("qa"))
+}
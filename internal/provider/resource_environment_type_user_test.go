@@ -17,7 +17,7 @@ func TestAccResourceEnvironmentTypeUser(t *testing.T) {
 	id := fmt.Sprintf("env-type-user-test-%d", time.Now().UnixNano())
 	testUserID := "c0725726-0613-43d4-8398-907d07fba2e4"
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -59,19 +59,15 @@ func TestAccResourceEnvironmentTypeUserDeletedManually(t *testing.T) {
 
 	orgID := os.Getenv("HUMANITEC_ORG")
 	token := os.Getenv("HUMANITEC_TOKEN")
-	apiHost := os.Getenv("HUMANITEC_HOST")
-	if apiHost == "" {
-		apiHost = humanitec.DefaultAPIHost
-	}
 
 	var client *humanitec.Client
 	var err error
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
 
-			client, err = NewHumanitecClient(apiHost, token, "test", nil)
+			client, err = NewHumanitecClient(testAccAPIPrefix(), token, "test", nil)
 			assert.NoError(err)
 		},
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ResourceGraphDataSource{}
+
+func NewResourceGraphDataSource() datasource.DataSource {
+	return &ResourceGraphDataSource{}
+}
+
+// ResourceGraphDataSource defines the data source implementation.
+type ResourceGraphDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// ResourceGraphDataSourceModel describes the data source data model.
+type ResourceGraphDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	AppID     types.String `tfsdk:"app_id"`
+	EnvID     types.String `tfsdk:"env_id"`
+	GraphJSON types.String `tfsdk:"graph_json"`
+}
+
+// resourceGraph is the JSON document exposed as graph_json.
+type resourceGraph struct {
+	Nodes []resourceGraphNode `json:"nodes"`
+}
+
+type resourceGraphNode struct {
+	ID         string   `json:"id"`
+	ResID      string   `json:"res_id"`
+	Type       string   `json:"type"`
+	Class      string   `json:"class"`
+	DefID      string   `json:"def_id"`
+	DriverType string   `json:"driver_type"`
+	DependsOn  []string `json:"depends_on"`
+}
+
+func (d *ResourceGraphDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_graph"
+}
+
+func (d *ResourceGraphDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Resource Graph of the latest deployment of an environment, i.e. its Active Resources and their dependencies, e.g. to feed architecture documentation.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The Application ID.",
+				Required:            true,
+			},
+			"env_id": schema.StringAttribute{
+				MarkdownDescription: "The Environment ID.",
+				Required:            true,
+			},
+			"graph_json": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded graph with a `nodes` list, sorted by `id`. Each node has the Globally Unique Resource `id`, `res_id`, `type`, `class`, `def_id`, `driver_type` and the `depends_on` list of node ids.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ResourceGraphDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *ResourceGraphDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ResourceGraphDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := data.AppID.ValueString()
+	envID := data.EnvID.ValueString()
+
+	activeResp, err := d.client.ListActiveResourcesWithResponse(ctx, d.orgId, appID, envID)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list active resources, got error: %s", err))
+		return
+	}
+	if activeResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list active resources, unexpected status code: %d, body: %s", activeResp.StatusCode(), activeResp.Body))
+		return
+	}
+
+	// Active resources of the same deployment share its dependency graph
+	deployIDs := map[string]bool{}
+	if activeResp.JSON200 != nil {
+		for _, res := range *activeResp.JSON200 {
+			if res.DeployId != "" {
+				deployIDs[res.DeployId] = true
+			}
+		}
+	}
+
+	graphIDs := map[string]bool{}
+	for deployID := range deployIDs {
+		deployResp, err := d.client.GetDeploymentWithResponse(ctx, d.orgId, appID, envID, deployID)
+		if err != nil {
+			resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to read deployment %s, got error: %s", deployID, err))
+			return
+		}
+		if deployResp.StatusCode() != 200 {
+			resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to read deployment %s, unexpected status code: %d, body: %s", deployID, deployResp.StatusCode(), deployResp.Body))
+			return
+		}
+		if deployResp.JSON200 != nil && deployResp.JSON200.DependencyGraphId != nil && *deployResp.JSON200.DependencyGraphId != "" {
+			graphIDs[*deployResp.JSON200.DependencyGraphId] = true
+		}
+	}
+
+	nodes := []client.NodeBodyResponse{}
+	for graphID := range graphIDs {
+		graphResp, err := d.client.GetDependencyGraphWithResponse(ctx, d.orgId, appID, envID, graphID)
+		if err != nil {
+			resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to read dependency graph %s, got error: %s", graphID, err))
+			return
+		}
+		if graphResp.StatusCode() != 200 {
+			resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to read dependency graph %s, unexpected status code: %d, body: %s", graphID, graphResp.StatusCode(), graphResp.Body))
+			return
+		}
+		if graphResp.JSON200 != nil {
+			nodes = append(nodes, graphResp.JSON200.Nodes...)
+		}
+	}
+
+	graphJSON, err := json.Marshal(newResourceGraph(nodes))
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_PROVIDER_ERR, fmt.Sprintf("Unable to encode resource graph, got error: %s", err))
+		return
+	}
+
+	data.GraphJSON = types.StringValue(string(graphJSON))
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", appID, envID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newResourceGraph converts dependency graph nodes into a resourceGraph, nodes present in multiple graphs are only included once.
+func newResourceGraph(nodes []client.NodeBodyResponse) resourceGraph {
+	graph := resourceGraph{Nodes: []resourceGraphNode{}}
+	seen := map[string]bool{}
+	for _, node := range nodes {
+		if seen[node.Guresid] {
+			continue
+		}
+		seen[node.Guresid] = true
+
+		dependsOn := append([]string{}, node.DependsOn...)
+		sort.Strings(dependsOn)
+
+		graph.Nodes = append(graph.Nodes, resourceGraphNode{
+			ID:         node.Guresid,
+			ResID:      node.Id,
+			Type:       node.Type,
+			Class:      node.Class,
+			DefID:      node.DefId,
+			DriverType: node.DriverType,
+			DependsOn:  dependsOn,
+		})
+	}
+
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].ID < graph.Nodes[j].ID
+	})
+
+	return graph
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/humanitec/humanitec-go-autogen/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccResourceGraphDataSource(t *testing.T) {
+	appID := fmt.Sprintf("tf-resource-graph-%d", time.Now().UnixNano())
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGraphDataSourceConfig(appID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.humanitec_resource_graph.test", "id", fmt.Sprintf("%s/development", appID)),
+					resource.TestCheckResourceAttr("data.humanitec_resource_graph.test", "graph_json", `{"nodes":[]}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceGraphDataSourceConfig(appID string) string {
+	return fmt.Sprintf(`
+resource "humanitec_application" "test" {
+	id   = "%s"
+	name = "resource-graph-test"
+}
+
+data "humanitec_resource_graph" "test" {
+	app_id = humanitec_application.test.id
+	env_id = "development"
+}
+`, appID)
+}
+
+func TestNewResourceGraph(t *testing.T) {
+	assert := assert.New(t)
+
+	graph := newResourceGraph([]client.NodeBodyResponse{
+		{Guresid: "b", Id: "modules.api", Type: "workload", Class: "default", DefId: "workload-def", DriverType: "humanitec/template", DependsOn: []string{"c", "a"}},
+		{Guresid: "a", Id: "shared.db", Type: "postgres", Class: "default", DefId: "db-def", DriverType: "humanitec/postgres"},
+		{Guresid: "b", Id: "modules.api", Type: "workload", Class: "default", DefId: "workload-def", DriverType: "humanitec/template", DependsOn: []string{"c", "a"}},
+	})
+
+	graphJSON, err := json.Marshal(graph)
+	assert.NoError(err)
+	assert.JSONEq(`{"nodes": [
+		{"id": "a", "res_id": "shared.db", "type": "postgres", "class": "default", "def_id": "db-def", "driver_type": "humanitec/postgres", "depends_on": []},
+		{"id": "b", "res_id": "modules.api", "type": "workload", "class": "default", "def_id": "workload-def", "driver_type": "humanitec/template", "depends_on": ["a", "c"]}
+	]}`, string(graphJSON))
+}
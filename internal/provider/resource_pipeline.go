@@ -2,25 +2,35 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/humanitec/humanitec-go-autogen"
 	"github.com/humanitec/humanitec-go-autogen/client"
+	"sigs.k8s.io/yaml"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &ResourcePipeline{}
 var _ resource.ResourceWithImportState = &ResourcePipeline{}
+var _ resource.ResourceWithModifyPlan = &ResourcePipeline{}
 
 func NewResourcePipeline() resource.Resource {
 	return &ResourcePipeline{}
@@ -30,6 +40,7 @@ func NewResourcePipeline() resource.Resource {
 type ResourcePipeline struct {
 	client *humanitec.Client
 	orgID  string
+	data   *HumanitecData
 }
 
 func (r *ResourcePipeline) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,8 +60,16 @@ func (r *ResourcePipeline) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"definition": schema.StringAttribute{
-				MarkdownDescription: "The YAML definition of the pipeline.",
-				Required:            true,
+				MarkdownDescription: "The YAML definition of the pipeline. Exactly one of definition or definition_file has to be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("definition_file")),
+				},
+			},
+			"definition_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a file containing the YAML definition of the pipeline, e.g. `\"${path.module}/pipelines/release.yaml\"`. Lines consisting of `{{ include \"<path>\" }}` are replaced with the content of the file at the path relative to the including file, indented like the include, so that large definitions can be split into files. The resolved definition is planned as `definition`.",
+				Optional:            true,
 			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The id of the Pipeline.",
@@ -74,39 +93,167 @@ func (r *ResourcePipeline) Schema(ctx context.Context, req resource.SchemaReques
 				ElementType:         types.StringType,
 				Computed:            true,
 			},
+			"inputs_schema": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded inputs of the `pipeline_call` trigger, as defined in `on.pipeline_call.inputs` of the definition. It's known during plan, so that modules creating pipeline runs can validate their inputs against it. Null if the pipeline has no inputs.",
+				Computed:            true,
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 func (r *ResourcePipeline) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
+		return
+	}
+
 	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	if resdata == nil {
 		return
 	}
 
-	resdata, ok := req.ProviderData.(*HumanitecData)
+	r.client = resdata.Client
+	r.orgID = resdata.OrgID
+	r.data = resdata
+}
 
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+func (r *ResourcePipeline) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.data.validateAppReference(ctx, req, resp)
 
+	// Skip destroy plans
+	if req.Plan.Raw.IsNull() {
 		return
 	}
 
-	r.client = resdata.Client
-	r.orgID = resdata.OrgID
+	r.planDefinitionFile(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var definition types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("definition"), &definition)...)
+	if resp.Diagnostics.HasError() || definition.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("inputs_schema"), pipelineInputsSchema(definition.ValueString()))...)
+}
+
+// planDefinitionFile plans the resolved content of definition_file as definition. The definition of the state is kept if
+// it only differs in formatting, e.g. after an import.
+func (r *ResourcePipeline) planDefinitionFile(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var definitionFile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("definition_file"), &definitionFile)...)
+	if resp.Diagnostics.HasError() || definitionFile.IsNull() || definitionFile.IsUnknown() {
+		return
+	}
+
+	definition, err := resolvePipelineDefinitionFile(definitionFile.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("definition_file"), HUM_INPUT_ERR, fmt.Sprintf("Unable to read the pipeline definition: %s", err))
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateDefinition types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("definition"), &stateDefinition)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if yamlEqual(stateDefinition.ValueString(), definition) {
+			definition = stateDefinition.ValueString()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("definition"), definition)...)
+}
+
+var pipelineIncludeRegexp = regexp.MustCompile(`^(\s*)\{\{\s*include\s+"([^"]+)"\s*\}\}\s*$`)
+
+// resolvePipelineDefinitionFile reads a pipeline definition file and replaces its include lines with the content of the
+// included files, which can include further files.
+func resolvePipelineDefinitionFile(name string) (string, error) {
+	return resolvePipelineIncludes(filepath.Clean(name), nil)
+}
+
+func resolvePipelineIncludes(name string, including []string) (string, error) {
+	if slices.Contains(including, name) {
+		return "", fmt.Errorf("%s is included recursively: %s -> %s", name, strings.Join(including, " -> "), name)
+	}
+
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	for _, line := range strings.SplitAfter(string(content), "\n") {
+		match := pipelineIncludeRegexp.FindStringSubmatch(strings.TrimRight(line, "\r\n"))
+		if match == nil {
+			b.WriteString(line)
+			continue
+		}
+
+		included, err := resolvePipelineIncludes(filepath.Join(filepath.Dir(name), match[2]), append(slices.Clone(including), name))
+		if err != nil {
+			return "", err
+		}
+
+		// Indent the included content like the include
+		for _, includedLine := range strings.SplitAfter(strings.TrimRight(included, "\n"), "\n") {
+			b.WriteString(match[1] + includedLine)
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String(), nil
+}
+
+// pipelineInputsSchema returns the JSON encoded inputs of the pipeline_call trigger of a pipeline definition, null if there are none.
+// Invalid definitions are rejected by the API and result in null as well.
+func pipelineInputsSchema(definition string) types.String {
+	var parsed map[string]interface{}
+	if err := yaml.Unmarshal([]byte(definition), &parsed); err != nil {
+		return types.StringNull()
+	}
+
+	// An unquoted on key is a boolean in YAML 1.1
+	on, ok := parsed["on"].(map[string]interface{})
+	if !ok {
+		on, _ = parsed["true"].(map[string]interface{})
+	}
+	pipelineCall, _ := on["pipeline_call"].(map[string]interface{})
+	inputs, ok := pipelineCall["inputs"].(map[string]interface{})
+	if !ok || len(inputs) == 0 {
+		return types.StringNull()
+	}
+
+	b, err := json.Marshal(inputs)
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(string(b))
 }
 
 type PipelineModel struct {
-	AppID        types.String `tfsdk:"app_id"`
-	ID           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	Version      types.String `tfsdk:"version"`
-	Metadata     types.Map    `tfsdk:"metadata"`
-	TriggerTypes types.Set    `tfsdk:"trigger_types"`
-	Definition   types.String `tfsdk:"definition"`
+	AppID          types.String `tfsdk:"app_id"`
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Version        types.String `tfsdk:"version"`
+	Metadata       types.Map    `tfsdk:"metadata"`
+	TriggerTypes   types.Set    `tfsdk:"trigger_types"`
+	Definition     types.String `tfsdk:"definition"`
+	DefinitionFile types.String `tfsdk:"definition_file"`
+	InputsSchema   types.String `tfsdk:"inputs_schema"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ResourcePipeline) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -119,6 +266,12 @@ func (r *ResourcePipeline) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	appID := data.AppID.ValueString()
 	definition := data.Definition.ValueString()
 
@@ -147,6 +300,7 @@ func (r *ResourcePipeline) Create(ctx context.Context, req resource.CreateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	data.InputsSchema = pipelineInputsSchema(definition)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -162,6 +316,12 @@ func (r *ResourcePipeline) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	appID := data.AppID.ValueString()
 	id := data.ID.ValueString()
 
@@ -197,10 +357,14 @@ func (r *ResourcePipeline) Read(ctx context.Context, req resource.ReadRequest, r
 		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to get pipeline definition, got error: %s", err))
 		return
 	}
-	switch getPipelineResp.StatusCode() {
+	switch getPipelineDefinitionResp.StatusCode() {
 	case http.StatusOK:
 		definition := string(getPipelineDefinitionResp.Body)
-		data.Definition = types.StringValue(definition)
+		// Keep the configured formatting if the API only re-serialized the definition
+		if !yamlEqual(data.Definition.ValueString(), definition) {
+			data.Definition = types.StringValue(definition)
+		}
+		data.InputsSchema = pipelineInputsSchema(data.Definition.ValueString())
 	default:
 		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to get pipeline definition, unexpected status code: %d, body: %s", getPipelineDefinitionResp.StatusCode(), getPipelineDefinitionResp.Body))
 		return
@@ -220,6 +384,12 @@ func (r *ResourcePipeline) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	appID := state.AppID.ValueString()
 	id := state.ID.ValueString()
 	definition := data.Definition.ValueString()
@@ -252,6 +422,7 @@ func (r *ResourcePipeline) Update(ctx context.Context, req resource.UpdateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	data.InputsSchema = pipelineInputsSchema(definition)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -266,6 +437,12 @@ func (r *ResourcePipeline) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	appID := data.AppID.ValueString()
 	id := data.ID.ValueString()
 
@@ -293,14 +470,21 @@ func (r *ResourcePipeline) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *ResourcePipeline) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
+	idParts, err := importIDParts(req.ID, r.orgID, "apps", "pipelines")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Unable to parse import URL %q: %s", req.ID, err),
+		)
+		return
+	}
 
 	// ensure idParts elements are not empty
 	for _, idPart := range idParts {
 		if idPart == "" {
 			resp.Diagnostics.AddError(
 				"Unexpected Import Identifier",
-				fmt.Sprintf("Expected import identifier with format: app_id/pipeline_id. Got: %q", req.ID),
+				fmt.Sprintf("Expected import identifier with format: app_id/pipeline_id or a Humanitec console URL. Got: %q", req.ID),
 			)
 			return
 		}
@@ -312,7 +496,7 @@ func (r *ResourcePipeline) ImportState(ctx context.Context, req resource.ImportS
 	} else {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: app_id/pipeline_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: app_id/pipeline_id or a Humanitec console URL. Got: %q", req.ID),
 		)
 		return
 	}
@@ -2,26 +2,35 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/humanitec/humanitec-go-autogen"
 	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/pagination"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &ResourcePipelineCriteria{}
 var _ resource.ResourceWithImportState = &ResourcePipelineCriteria{}
 
+const defaultPipelineCriteriaActivationTimeout = 2 * time.Minute
+
 func NewResourcePipelineCriteria() resource.Resource {
 	return &ResourcePipelineCriteria{}
 }
@@ -93,24 +102,25 @@ in any environment which matches the criteria.
 					objectplanmodifier.RequiresReplace(),
 				},
 			},
+			"wait_for_active": schema.BoolAttribute{
+				MarkdownDescription: "Wait after creation until the criteria is listed for the application, so that subsequent deployments don't fall back to the default pipeline.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
 
 func (r *ResourcePipelineCriteria) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
 		return
 	}
 
-	resdata, ok := req.ProviderData.(*HumanitecData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
 		return
 	}
 
@@ -132,6 +142,7 @@ type pipelineCriteriaModel struct {
 	PipelineName      types.String                            `tfsdk:"pipeline_name"`
 	Id                types.String                            `tfsdk:"id"`
 	DeploymentRequest *pipelineCriteriaDeploymentRequestModel `tfsdk:"deployment_request"`
+	WaitForActive     types.Bool                              `tfsdk:"wait_for_active"`
 }
 
 func (pcm *pipelineCriteriaModel) updateFromContent(res *client.PipelineCriteria) diag.Diagnostics {
@@ -187,7 +198,16 @@ func (r *ResourcePipelineCriteria) Create(ctx context.Context, req resource.Crea
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		// Save the criteria before waiting so that a criteria which doesn't become active isn't left untracked
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if data.WaitForActive.ValueBool() {
+			if err := r.waitForActive(ctx, data.AppID.ValueString(), data.PipelineId.ValueString(), data.Id.ValueString()); err != nil {
+				resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Pipeline criteria %s was created but didn't become active, got error: %s", data.Id.ValueString(), err))
+			}
+		}
 	case http.StatusBadRequest:
 		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to create pipeline criteria, Humanitec returned bad request: %s", clientResp.Body))
 		return
@@ -195,7 +215,7 @@ func (r *ResourcePipelineCriteria) Create(ctx context.Context, req resource.Crea
 		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to create pipeline criteria, organization or application not found: %s", clientResp.Body))
 		return
 	case http.StatusConflict:
-		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to create pipeline criteria due to a conflicts: %s", clientResp.Body))
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to create pipeline criteria due to a conflicts: %s", pipelineCriteriaConflictDetails(clientResp.Body)))
 		return
 	default:
 		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Received unexpected status code when creating pipeline criteria: %d, body: %s", clientResp.StatusCode(), clientResp.Body))
@@ -223,6 +243,10 @@ func (r *ResourcePipelineCriteria) Read(ctx context.Context, req resource.ReadRe
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		// wait_for_active is client-only, fall back to its default after an import
+		if data.WaitForActive.IsNull() {
+			data.WaitForActive = types.BoolValue(false)
+		}
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	case http.StatusNotFound:
 		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to get pipeline criteria, organization or application not found: %s", clientResp.Body))
@@ -234,8 +258,18 @@ func (r *ResourcePipelineCriteria) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *ResourcePipelineCriteria) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// you can't update criteria in place, all updates should be done with a replacement
-	resp.Diagnostics.AddError(HUM_CLIENT_ERR, "Unable to update pipeline criteria")
+	var data, state *pipelineCriteriaModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update client-only attributes
+	state.WaitForActive = data.WaitForActive
+
+	// you can't update criteria in place, all other updates are done with a replacement
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *ResourcePipelineCriteria) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -264,6 +298,60 @@ func (r *ResourcePipelineCriteria) Delete(ctx context.Context, req resource.Dele
 	}
 }
 
+// waitForActive polls the pipeline criteria of the application until the criteria is listed, as deployments are only matched against listed criteria.
+func (r *ResourcePipelineCriteria) waitForActive(ctx context.Context, appID, pipelineID, criteriaID string) error {
+	return retry.RetryContext(ctx, defaultPipelineCriteriaActivationTimeout, func() *retry.RetryError {
+		criteria, err := pagination.All(ctx, func(ctx context.Context, editor pagination.RequestEditor) ([]client.PipelineCriteria, *http.Response, error) {
+			httpResp, err := r.client.ListPipelineCriteriaInAppWithResponse(ctx, r.orgID, appID, &client.ListPipelineCriteriaInAppParams{
+				Pipeline: &pipelineID,
+			}, editor)
+			if err != nil {
+				return nil, nil, err
+			}
+			if httpResp.StatusCode() != http.StatusOK {
+				return nil, nil, fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+			}
+			if httpResp.JSON200 == nil {
+				return nil, httpResp.HTTPResponse, nil
+			}
+			return *httpResp.JSON200, httpResp.HTTPResponse, nil
+		})
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		for _, c := range criteria {
+			if c.Id == criteriaID {
+				return nil
+			}
+		}
+		return retry.RetryableError(fmt.Errorf("pipeline criteria %s isn't active yet", criteriaID))
+	})
+}
+
+// pipelineCriteriaConflictDetails formats the error returned when creating criteria that conflict with existing ones,
+// listing the details of the conflicting criteria if the body contains them.
+func pipelineCriteriaConflictDetails(body []byte) string {
+	var conflict struct {
+		Message string                 `json:"message"`
+		Details map[string]interface{} `json:"details"`
+	}
+	if err := json.Unmarshal(body, &conflict); err != nil || conflict.Message == "" {
+		return string(body)
+	}
+	if len(conflict.Details) == 0 {
+		return conflict.Message
+	}
+
+	details := make([]string, 0, len(conflict.Details))
+	for k, v := range conflict.Details {
+		details = append(details, fmt.Sprintf("%s: %v", k, v))
+	}
+	sort.Strings(details)
+
+	return fmt.Sprintf("%s (conflicting criteria %s)", conflict.Message, strings.Join(details, ", "))
+}
+
 func (r *ResourcePipelineCriteria) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	idParts := strings.Split(req.ID, "/")
 	if len(idParts) != 3 {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestResourcePipelineCriteria(t *testing.T) {
@@ -53,10 +54,11 @@ resource humanitec_pipeline_criteria "c1" {
 		env_type = "development"
         deployment_type = "re-deploy"
     }
+	wait_for_active = true
 }
 `
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		// check whether env vars are set
 		PreCheck: func() { testAccPreCheck(t) },
 		// get the humanitec provider for tests
@@ -96,6 +98,7 @@ resource humanitec_pipeline_criteria "c1" {
 					resource.TestCheckResourceAttrSet("humanitec_pipeline_criteria.c1", "deployment_request.app_id"),
 					resource.TestCheckResourceAttr("humanitec_pipeline_criteria.c1", "deployment_request.env_type", "development"),
 					resource.TestCheckResourceAttr("humanitec_pipeline_criteria.c1", "deployment_request.deployment_type", "re-deploy"),
+					resource.TestCheckResourceAttr("humanitec_pipeline_criteria.c1", "wait_for_active", "true"),
 				),
 			},
 			// now let's test that we can import things reliably
@@ -111,8 +114,39 @@ resource humanitec_pipeline_criteria "c1" {
 					}
 					return "", fmt.Errorf("failed to find resource in state")
 				},
-				ImportStateVerify: true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_active"},
 			},
 		},
 	})
 }
+
+func TestPipelineCriteriaConflictDetails(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "with details",
+			body:     `{"error": "API-409", "message": "Criteria conflict with existing criteria.", "details": {"pipeline_id": "other", "id": "abc"}}`,
+			expected: "Criteria conflict with existing criteria. (conflicting criteria id: abc, pipeline_id: other)",
+		},
+		{
+			name:     "without details",
+			body:     `{"error": "API-409", "message": "Criteria conflict with existing criteria."}`,
+			expected: "Criteria conflict with existing criteria.",
+		},
+		{
+			name:     "not json",
+			body:     "conflict",
+			expected: "conflict",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, pipelineCriteriaConflictDetails([]byte(tc.body)))
+		})
+	}
+}
@@ -2,11 +2,15 @@ package provider
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccResourcePipeline(t *testing.T) {
@@ -28,6 +32,10 @@ jobs:
 name: Hello from terraform - update
 on: 
   pipeline_call:
+    inputs:
+      environment:
+        type: string
+        required: true
 jobs:
   approve:
     steps:
@@ -38,7 +46,7 @@ jobs:
         message: Test message
 `
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -48,6 +56,7 @@ jobs:
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("humanitec_pipeline.pipeline_test", "app_id", appID),
 					resource.TestCheckResourceAttr("humanitec_pipeline.pipeline_test", "definition", definition+"\n"),
+					resource.TestCheckNoResourceAttr("humanitec_pipeline.pipeline_test", "inputs_schema"),
 				),
 			},
 			// ImportState testing
@@ -75,6 +84,7 @@ jobs:
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("humanitec_pipeline.pipeline_test", "app_id", appID),
 					resource.TestCheckResourceAttr("humanitec_pipeline.pipeline_test", "definition", newDefinition+"\n"),
+					resource.TestCheckResourceAttr("humanitec_pipeline.pipeline_test", "inputs_schema", `{"environment":{"required":true,"type":"string"}}`),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -96,3 +106,91 @@ resource "humanitec_pipeline" "pipeline_test" {
 EOT
 }`, app, definition)
 }
+
+func TestPipelineInputsSchema(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		expected   types.String
+	}{
+		{
+			name:       "inputs",
+			definition: "on:\n  pipeline_call:\n    inputs:\n      env:\n        type: string\n        default: development\n",
+			expected:   types.StringValue(`{"env":{"default":"development","type":"string"}}`),
+		},
+		{
+			name:       "quoted on",
+			definition: "\"on\":\n  pipeline_call:\n    inputs:\n      env:\n        type: string\n",
+			expected:   types.StringValue(`{"env":{"type":"string"}}`),
+		},
+		{name: "no inputs", definition: "on:\n  pipeline_call:\n", expected: types.StringNull()},
+		{name: "no pipeline_call", definition: "on:\n  deployment_request: {}\n", expected: types.StringNull()},
+		{name: "invalid", definition: "on: [", expected: types.StringNull()},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, pipelineInputsSchema(tc.definition))
+		})
+	}
+}
+
+func TestResolvePipelineDefinitionFile(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+
+	write := func(name, content string) {
+		path := filepath.Join(dir, name)
+		assert.NoError(os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(os.WriteFile(path, []byte(content), 0644))
+	}
+
+	write("release.yaml", `name: Release
+on:
+  pipeline_call: {}
+jobs:
+  build:
+    steps:
+    {{ include "steps/build.yaml" }}
+    - uses: actions/humanitec/log
+      with:
+        message: done
+`)
+	write("steps/build.yaml", `- uses: actions/humanitec/log
+  with:
+    message: building
+{{ include "notify.yaml" }}
+`)
+	write("steps/notify.yaml", `- uses: actions/humanitec/log
+  with:
+    message: notify
+`)
+
+	definition, err := resolvePipelineDefinitionFile(filepath.Join(dir, "release.yaml"))
+	assert.NoError(err)
+	assert.Equal(`name: Release
+on:
+  pipeline_call: {}
+jobs:
+  build:
+    steps:
+    - uses: actions/humanitec/log
+      with:
+        message: building
+    - uses: actions/humanitec/log
+      with:
+        message: notify
+    - uses: actions/humanitec/log
+      with:
+        message: done
+`, definition)
+
+	write("loop.yaml", `{{ include "steps/loop.yaml" }}`)
+	write("steps/loop.yaml", `{{ include "../loop.yaml" }}`)
+	_, err = resolvePipelineDefinitionFile(filepath.Join(dir, "loop.yaml"))
+	assert.ErrorContains(err, "is included recursively")
+
+	write("missing.yaml", `{{ include "steps/missing.yaml" }}`)
+	_, err = resolvePipelineDefinitionFile(filepath.Join(dir, "missing.yaml"))
+	assert.ErrorIs(err, os.ErrNotExist)
+}
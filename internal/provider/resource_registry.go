@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -24,6 +26,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &ResourceRegistry{}
 var _ resource.ResourceWithImportState = &ResourceRegistry{}
+var _ resource.ResourceWithModifyPlan = &ResourceRegistry{}
 
 func NewResourceRegistry() resource.Resource {
 	return &ResourceRegistry{}
@@ -55,8 +58,11 @@ func (r *ResourceRegistry) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"registry": schema.StringAttribute{
-				MarkdownDescription: "Registry name, usually in a \"{domain}\" or \"{domain}/{project}\" format.",
+				MarkdownDescription: "Registry name, usually in a \"{domain}\" or \"{domain}/{project}\" format. Validated against the `type`: `amazon_ecr` expects an ECR hostname like `123456789012.dkr.ecr.eu-west-1.amazonaws.com` or a repository ARN, `google_gcr` a Container Registry or Artifact Registry hostname like `gcr.io/my-project` or `europe-west3-docker.pkg.dev/my-project/my-repo` and `basic` or `secret_ref` a domain without scheme.",
 				Required:            true,
+				Validators: []validator.String{
+					registryFormatValidator{},
+				},
 			},
 			"type": schema.StringAttribute{
 				MarkdownDescription: "Registry type, describes the registry authentication method, and defines the schema for the credentials.",
@@ -82,9 +88,24 @@ func (r *ResourceRegistry) Schema(ctx context.Context, req resource.SchemaReques
 				},
 				Sensitive: true,
 			},
+			"rotate_after": schema.StringAttribute{
+				MarkdownDescription: "Resend `creds` once this RFC3339 timestamp has passed, or once this duration (e.g. `720h`) has passed since they were last sent. Allows to rotate short-lived credentials, e.g. robot account tokens, on a schedule.",
+				Optional:            true,
+				Validators: []validator.String{
+					rotateAfterValidator{},
+				},
+			},
+			"creds_updated_at": schema.StringAttribute{
+				MarkdownDescription: "The RFC3339 timestamp of when `creds` were last sent to Humanitec.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"secrets": schema.MapNestedAttribute{
 				MarkdownDescription: "ClusterSecretsMap stores a list of Kuberenetes secret references for the target deployment clusters.",
 				Optional:            true,
+				Sensitive:           true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"namespace": schema.StringAttribute{
@@ -101,19 +122,14 @@ func (r *ResourceRegistry) Schema(ctx context.Context, req resource.SchemaReques
 }
 
 func (r *ResourceRegistry) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
 		return
 	}
 
-	resdata, ok := req.ProviderData.(*HumanitecData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
 		return
 	}
 
@@ -127,12 +143,14 @@ type RegistryCredsModel struct {
 }
 
 type RegistryModel struct {
-	ID       types.String             `tfsdk:"id"`
-	Registry types.String             `tfsdk:"registry"`
-	Type     types.String             `tfsdk:"type"`
-	EnableCI types.Bool               `tfsdk:"enable_ci"`
-	Creds    *RegistryCredsModel      `tfsdk:"creds"`
-	Secrets  *map[string]SecretsModel `tfsdk:"secrets"`
+	ID             types.String             `tfsdk:"id"`
+	Registry       types.String             `tfsdk:"registry"`
+	Type           types.String             `tfsdk:"type"`
+	EnableCI       types.Bool               `tfsdk:"enable_ci"`
+	Creds          *RegistryCredsModel      `tfsdk:"creds"`
+	RotateAfter    types.String             `tfsdk:"rotate_after"`
+	CredsUpdatedAt types.String             `tfsdk:"creds_updated_at"`
+	Secrets        *map[string]SecretsModel `tfsdk:"secrets"`
 }
 
 type SecretsModel struct {
@@ -140,6 +158,34 @@ type SecretsModel struct {
 	Secret    types.String `tfsdk:"secret"`
 }
 
+func (r *ResourceRegistry) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip create and destroy plans
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *RegistryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Creds == nil {
+		return
+	}
+
+	due := !registryCredsEqual(plan.Creds, state.Creds)
+	if !due && !plan.RotateAfter.IsNull() && !plan.RotateAfter.IsUnknown() {
+		var err error
+		due, err = credsRotationDue(plan.RotateAfter.ValueString(), state.CredsUpdatedAt, time.Now())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("rotate_after"), HUM_INPUT_ERR, err.Error())
+			return
+		}
+	}
+
+	if due {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("creds_updated_at"), types.StringUnknown())...)
+	}
+}
+
 func (r *ResourceRegistry) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *RegistryModel
 
@@ -182,6 +228,8 @@ func (r *ResourceRegistry) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	data.CredsUpdatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -277,6 +325,11 @@ func (r *ResourceRegistry) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	// Only planned as unknown when the creds changed or their rotation is due
+	if data.CredsUpdatedAt.IsUnknown() {
+		data.CredsUpdatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -381,3 +434,123 @@ func parseRegistryResponse(res *client.RegistryResponse, data *RegistryModel) di
 
 	return totalDiags
 }
+
+func registryCredsEqual(a, b *RegistryCredsModel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Username.Equal(b.Username) && a.Password.Equal(b.Password)
+}
+
+// credsRotationDue reports whether creds last sent at updatedAt have to be resent, rotateAfter is either a RFC3339 timestamp or a duration.
+func credsRotationDue(rotateAfter string, updatedAt types.String, now time.Time) (bool, error) {
+	// Unknown when the creds were sent, e.g. after an import
+	if updatedAt.IsNull() || updatedAt.IsUnknown() {
+		return true, nil
+	}
+
+	lastUpdate, err := time.Parse(time.RFC3339, updatedAt.ValueString())
+	if err != nil {
+		return false, fmt.Errorf("creds_updated_at %q is not a RFC3339 timestamp: %w", updatedAt.ValueString(), err)
+	}
+
+	var threshold time.Time
+	if d, err := time.ParseDuration(rotateAfter); err == nil {
+		threshold = lastUpdate.Add(d)
+	} else if threshold, err = time.Parse(time.RFC3339, rotateAfter); err != nil {
+		return false, fmt.Errorf("rotate_after %q is neither a RFC3339 timestamp nor a duration", rotateAfter)
+	}
+
+	// A passed timestamp only triggers a single rotation
+	return lastUpdate.Before(threshold) && !now.Before(threshold), nil
+}
+
+// rotateAfterValidator ensures a string is either a RFC3339 timestamp or a positive duration.
+type rotateAfterValidator struct{}
+
+func (v rotateAfterValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v rotateAfterValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a RFC3339 timestamp, e.g. 2024-01-31T18:00:00Z, or a positive duration, e.g. 720h"
+}
+
+func (v rotateAfterValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if d, err := time.ParseDuration(value); err == nil {
+		if d <= 0 {
+			resp.Diagnostics.AddAttributeError(req.Path, HUM_INPUT_ERR, fmt.Sprintf("%q must be a positive duration", value))
+		}
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, HUM_INPUT_ERR,
+			fmt.Sprintf("%q is neither a RFC3339 timestamp nor a duration", value))
+	}
+}
+
+var (
+	registryPathPattern      = `(/[a-z0-9._-]+)*`
+	registryDomainRegexp     = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?)*(:[0-9]+)?` + registryPathPattern + `$`)
+	registryECRHostRegexp    = regexp.MustCompile(`^([0-9]{12}\.dkr\.ecr(-fips)?\.[a-z0-9-]+\.amazonaws\.com(\.cn)?|public\.ecr\.aws)` + registryPathPattern + `$`)
+	registryECRARNRegexp     = regexp.MustCompile(`^arn:aws(-[a-z]+)*:ecr:[a-z0-9-]+:[0-9]{12}:repository` + registryPathPattern + `$`)
+	registryGoogleHostRegexp = regexp.MustCompile(`^(([a-z]+\.)?gcr\.io|[a-z0-9-]+-docker\.pkg\.dev)` + registryPathPattern + `$`)
+)
+
+// checkRegistryFormat returns why registry isn't valid for the registry type, or nil. Unknown types are left to the API.
+func checkRegistryFormat(registryType, registry string) error {
+	if scheme, rest, ok := strings.Cut(registry, "://"); ok {
+		return fmt.Errorf("must not contain a scheme, use %q instead of %q", rest, scheme+"://"+rest)
+	}
+
+	switch registryType {
+	case "amazon_ecr":
+		if !registryECRHostRegexp.MatchString(registry) && !registryECRARNRegexp.MatchString(registry) {
+			return fmt.Errorf("must be an ECR hostname, e.g. 123456789012.dkr.ecr.eu-west-1.amazonaws.com, or a repository ARN, e.g. arn:aws:ecr:eu-west-1:123456789012:repository/my-repo")
+		}
+	case "google_gcr":
+		if !registryGoogleHostRegexp.MatchString(registry) {
+			return fmt.Errorf("must be a Container Registry hostname, e.g. gcr.io/my-project, or an Artifact Registry hostname, e.g. europe-west3-docker.pkg.dev/my-project/my-repo")
+		}
+	case "basic", "secret_ref":
+		if !registryDomainRegexp.MatchString(registry) {
+			return fmt.Errorf("must be a domain with an optional port and lowercase path, e.g. registry.example.com:5000/my-project")
+		}
+	}
+
+	return nil
+}
+
+// registryFormatValidator ensures the registry matches the format expected for the configured type.
+type registryFormatValidator struct{}
+
+func (v registryFormatValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v registryFormatValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a registry in the format expected for the registry type"
+}
+
+func (v registryFormatValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var registryType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &registryType)...)
+	if resp.Diagnostics.HasError() || registryType.IsNull() || registryType.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if err := checkRegistryFormat(registryType.ValueString(), value); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, HUM_INPUT_ERR, fmt.Sprintf("%q isn't a valid %s registry, it %s", value, registryType.ValueString(), err))
+	}
+}
@@ -41,7 +41,7 @@ func TestAccResourceRegistry(t *testing.T) {
 			id := fmt.Sprintf("test-%d", time.Now().UnixNano())
 			registry := fmt.Sprintf("test-%d.com.pl", time.Now().UnixNano())
 
-			resource.Test(t, resource.TestCase{
+			testAccTest(t, resource.TestCase{
 				PreCheck:                 func() { testAccPreCheck(t) },
 				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 				Steps: []resource.TestStep{
@@ -52,6 +52,7 @@ func TestAccResourceRegistry(t *testing.T) {
 							resource.TestCheckResourceAttr("humanitec_registry.registry_test", "id", id),
 							resource.TestCheckResourceAttr("humanitec_registry.registry_test", "registry", registry),
 							resource.TestCheckResourceAttr("humanitec_registry.registry_test", "enable_ci", "false"),
+							resource.TestCheckResourceAttrSet("humanitec_registry.registry_test", "creds_updated_at"),
 						),
 					},
 					// ImportState testing
@@ -60,7 +61,7 @@ func TestAccResourceRegistry(t *testing.T) {
 						ImportStateId:           id,
 						ImportState:             true,
 						ImportStateVerify:       true,
-						ImportStateVerifyIgnore: []string{"creds"},
+						ImportStateVerifyIgnore: []string{"creds", "creds_updated_at"},
 					},
 					// Update testing
 					{
@@ -129,3 +130,72 @@ func TestParseRegistryModel(t *testing.T) {
 	assert.Equal("test-username", model.Creds.Username)
 	assert.Equal("test-password", model.Creds.Password)
 }
+
+func TestCredsRotationDue(t *testing.T) {
+	now := time.Date(2024, 1, 31, 18, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		rotateAfter string
+		updatedAt   types.String
+		expected    bool
+		err         bool
+	}{
+		{name: "never sent", rotateAfter: "720h", updatedAt: types.StringNull(), expected: true},
+		{name: "duration passed", rotateAfter: "24h", updatedAt: types.StringValue("2024-01-30T18:00:00Z"), expected: true},
+		{name: "duration not passed", rotateAfter: "24h", updatedAt: types.StringValue("2024-01-30T18:00:01Z"), expected: false},
+		{name: "timestamp passed", rotateAfter: "2024-01-31T12:00:00Z", updatedAt: types.StringValue("2024-01-01T00:00:00Z"), expected: true},
+		{name: "timestamp not passed", rotateAfter: "2024-02-01T00:00:00Z", updatedAt: types.StringValue("2024-01-01T00:00:00Z"), expected: false},
+		{name: "timestamp already rotated", rotateAfter: "2024-01-31T12:00:00Z", updatedAt: types.StringValue("2024-01-31T13:00:00Z"), expected: false},
+		{name: "invalid", rotateAfter: "tomorrow", updatedAt: types.StringValue("2024-01-01T00:00:00Z"), err: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			due, err := credsRotationDue(tc.rotateAfter, tc.updatedAt, now)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, due)
+		})
+	}
+}
+
+func TestCheckRegistryFormat(t *testing.T) {
+	testCases := []struct {
+		registryType string
+		registry     string
+		valid        bool
+	}{
+		{registryType: "amazon_ecr", registry: "123456789012.dkr.ecr.eu-west-1.amazonaws.com", valid: true},
+		{registryType: "amazon_ecr", registry: "123456789012.dkr.ecr.cn-north-1.amazonaws.com.cn/team", valid: true},
+		{registryType: "amazon_ecr", registry: "arn:aws:ecr:eu-west-1:123456789012:repository/team/app", valid: true},
+		{registryType: "amazon_ecr", registry: "public.ecr.aws/my-alias", valid: true},
+		{registryType: "amazon_ecr", registry: "12345.dkr.ecr.eu-west-1.amazonaws.com"},
+		{registryType: "amazon_ecr", registry: "registry.example.com"},
+		{registryType: "google_gcr", registry: "gcr.io/my-project", valid: true},
+		{registryType: "google_gcr", registry: "eu.gcr.io/my-project", valid: true},
+		{registryType: "google_gcr", registry: "europe-west3-docker.pkg.dev/my-project/my-repo", valid: true},
+		{registryType: "google_gcr", registry: "docker.pkg.dev/my-project"},
+		{registryType: "basic", registry: "registry.example.com", valid: true},
+		{registryType: "basic", registry: "registry.example.com:5000/team", valid: true},
+		{registryType: "secret_ref", registry: "test-123.com.pl", valid: true},
+		{registryType: "basic", registry: "https://registry.example.com"},
+		{registryType: "basic", registry: "registry.example.com/Team"},
+		{registryType: "basic", registry: "registry example.com"},
+		{registryType: "unknown", registry: "anything goes", valid: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.registryType+"/"+tc.registry, func(t *testing.T) {
+			err := checkRegistryFormat(tc.registryType, tc.registry)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/humanitec/humanitec-go-autogen"
@@ -56,8 +60,11 @@ It is also possible to use 3rd party Resource Drivers or write your own.`,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID for this driver. Is used as `driver_type`.",
+				MarkdownDescription: "The ID for this driver. Is used as `driver_type`. Must not collide with the built-in `humanitec` drivers.",
 				Required:            true,
+				Validators: []validator.String{
+					driverIDValidator{},
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -74,9 +81,12 @@ It is also possible to use 3rd party Resource Drivers or write your own.`,
 			"target": schema.StringAttribute{
 				MarkdownDescription: "The prefix where the driver resides or, if the driver is a virtual driver, the reference to an existing driver using the `driver://` schema of the format `driver://{orgId}/{driverId}`. Only members of the organization the driver belongs to can see `target`.",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(driverTargetRegexp, "must be a driver URL using the https:// scheme or a reference to an existing driver in the format driver://{orgId}/{driverId}"),
+				},
 			},
 			"template": schema.StringAttribute{
-				MarkdownDescription: "If the driver is a virtual driver, template defines a Go template that converts the driver inputs supplied in the resource definition into the driver inputs for the target driver.",
+				MarkdownDescription: "If the driver is a virtual driver, template defines a Go template that converts the driver inputs supplied in the resource definition into the driver inputs for the target driver. Accepts JSON or YAML, differences in formatting or key order are ignored.",
 				Optional:            true,
 			},
 			"type": schema.StringAttribute{
@@ -87,20 +97,52 @@ It is also possible to use 3rd party Resource Drivers or write your own.`,
 	}
 }
 
-func (r *ResourceResourceDriver) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+var driverTargetRegexp = regexp.MustCompile(`^(https://[^\s]+|driver://[^/\s]+/[^/\s]+)$`)
+
+// reservedDriverIDs are ids which collide with drivers provided by Humanitec.
+var reservedDriverIDs = []string{"demo", "humanitec"}
+
+// driverIDValidator rejects driver ids which collide with the built-in humanitec drivers.
+type driverIDValidator struct{}
+
+func (v driverIDValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v driverIDValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value must not contain a namespace (/) and must not be one of %q", reservedDriverIDs)
+}
+
+func (v driverIDValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
 		return
 	}
 
-	resdata, ok := req.ProviderData.(*HumanitecData)
+	id := req.ConfigValue.ValueString()
+	if strings.Contains(id, "/") {
+		resp.Diagnostics.AddAttributeError(req.Path, HUM_INPUT_ERR,
+			fmt.Sprintf("Driver id %q must not contain a namespace, the organization namespace is added by Humanitec (e.g. {orgId}/%s). Drivers in the humanitec/* namespace are built-in and can't be managed.", id, id[strings.LastIndex(id, "/")+1:]))
+		return
+	}
 
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+	for _, reserved := range reservedDriverIDs {
+		if strings.EqualFold(id, reserved) {
+			resp.Diagnostics.AddAttributeError(req.Path, HUM_INPUT_ERR,
+				fmt.Sprintf("Driver id %q is reserved for the built-in humanitec drivers, please choose a different id.", id))
+			return
+		}
+	}
+}
 
+func (r *ResourceResourceDriver) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
 		return
 	}
 
@@ -124,11 +166,14 @@ func parseResourceDriverResponse(res *client.DriverDefinitionResponse, data *Res
 	data.InputsSchema = types.StringValue(string(bi))
 
 	if res.Template != nil {
-		bt, err := json.Marshal(res.Template)
-		if err != nil {
-			diags.AddError(HUM_API_ERR, fmt.Sprintf("Failed to marshal driver template: %s", err.Error()))
+		// Keep the configured template if it only differs in formatting, key order or encoding (JSON / YAML)
+		if data.Template.IsNull() || data.Template.IsUnknown() || !semanticallyEqual(data.Template.ValueString(), res.Template) {
+			bt, err := json.Marshal(res.Template)
+			if err != nil {
+				diags.AddError(HUM_API_ERR, fmt.Sprintf("Failed to marshal driver template: %s", err.Error()))
+			}
+			data.Template = types.StringValue(string(bt))
 		}
-		data.Template = types.StringValue(string(bt))
 	} else {
 		data.Template = types.StringNull()
 	}
@@ -161,7 +206,7 @@ func (r *ResourceResourceDriver) Create(ctx context.Context, req resource.Create
 	var template *interface{}
 
 	if data.Template.ValueStringPointer() != nil {
-		if err := json.Unmarshal([]byte(data.Template.ValueString()), &template); err != nil {
+		if err := unmarshalJSONOrYAML(data.Template.ValueString(), &template); err != nil {
 			resp.Diagnostics.Append(diag.NewErrorDiagnostic(HUM_API_ERR, fmt.Sprintf("Failed to unmarshal driver template: %s", err.Error())))
 			return
 		}
@@ -253,7 +298,7 @@ func (r *ResourceResourceDriver) Update(ctx context.Context, req resource.Update
 	var template *interface{}
 
 	if data.Template.ValueStringPointer() != nil {
-		if err := json.Unmarshal([]byte(data.Template.ValueString()), &template); err != nil {
+		if err := unmarshalJSONOrYAML(data.Template.ValueString(), &template); err != nil {
 			resp.Diagnostics.Append(diag.NewErrorDiagnostic(HUM_API_ERR, fmt.Sprintf("Failed to unmarshal driver template: %s", err.Error())))
 			return
 		}
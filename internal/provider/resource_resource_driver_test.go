@@ -7,6 +7,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/humanitec/humanitec-go-autogen"
@@ -20,6 +23,7 @@ func TestAccResourceResourceDriver(t *testing.T) {
 		configUpdate func(id string) string
 		testCreate   resource.TestCheckFunc
 		testUpdate   resource.TestCheckFunc
+		importIgnore []string
 	}{
 		{
 			name: "basic",
@@ -43,13 +47,26 @@ func TestAccResourceResourceDriver(t *testing.T) {
 			testCreate: resource.TestCheckResourceAttr("humanitec_resource_driver.s3", "template", "\"static\""),
 			testUpdate: resource.TestCheckResourceAttr("humanitec_resource_driver.s3", "template", "{\"type\":\"static\"}"),
 		},
+		{
+			name: "virtual-yaml",
+			configCreate: func(id string) string {
+				return testAccResourceResourceDriverVirtualYAML(id, "static")
+			},
+			configUpdate: func(id string) string {
+				return testAccResourceResourceDriverVirtualYAML(id, "dynamic")
+			},
+			testCreate: resource.TestCheckResourceAttr("humanitec_resource_driver.s3", "template", "type: static\n"),
+			testUpdate: resource.TestCheckResourceAttr("humanitec_resource_driver.s3", "template", "type: dynamic\n"),
+			// Imported template is normalized to JSON
+			importIgnore: []string{"template"},
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			id := fmt.Sprintf("driver-%d", time.Now().UnixNano())
 
-			resource.Test(t, resource.TestCase{
+			testAccTest(t, resource.TestCase{
 				PreCheck:                 func() { testAccPreCheck(t) },
 				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 				Steps: []resource.TestStep{
@@ -63,9 +80,10 @@ func TestAccResourceResourceDriver(t *testing.T) {
 					},
 					// ImportState testing
 					{
-						ResourceName:      "humanitec_resource_driver.s3",
-						ImportState:       true,
-						ImportStateVerify: true,
+						ResourceName:            "humanitec_resource_driver.s3",
+						ImportState:             true,
+						ImportStateVerify:       true,
+						ImportStateVerifyIgnore: tc.importIgnore,
 					},
 					// Update and Read testing
 					{
@@ -88,27 +106,23 @@ func TestAccResourceDriver_DeletedManually(t *testing.T) {
 
 	orgID := os.Getenv("HUMANITEC_ORG")
 	token := os.Getenv("HUMANITEC_TOKEN")
-	apiHost := os.Getenv("HUMANITEC_HOST")
-	if apiHost == "" {
-		apiHost = humanitec.DefaultAPIHost
-	}
 
 	var client *humanitec.Client
 	var err error
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
 
-			client, err = NewHumanitecClient(apiHost, token, "test", nil)
+			client, err = NewHumanitecClient(testAccAPIPrefix(), token, "test", nil)
 			assert.NoError(err)
 		},
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccResourceResourceDriver(id, "http://driver.driver:8080/driver"),
+				Config: testAccResourceResourceDriver(id, "https://driver.example.com/driver"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("humanitec_resource_driver.s3", "target", "http://driver.driver:8080/driver"),
+					resource.TestCheckResourceAttr("humanitec_resource_driver.s3", "target", "https://driver.example.com/driver"),
 					func(_ *terraform.State) error {
 						// Manually delete the resource driver via the API
 						resp, err := client.DeleteResourceDriverWithResponse(ctx, orgID, id)
@@ -129,6 +143,51 @@ func TestAccResourceDriver_DeletedManually(t *testing.T) {
 	})
 }
 
+func TestDriverIDValidator(t *testing.T) {
+	tests := []struct {
+		id    string
+		valid bool
+	}{
+		{id: "my-driver", valid: true},
+		{id: "demo", valid: false},
+		{id: "Humanitec", valid: false},
+		{id: "humanitec/postgres", valid: false},
+		{id: "my-org/my-driver", valid: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.id, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			driverIDValidator{}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("id"),
+				ConfigValue: types.StringValue(tc.id),
+			}, resp)
+
+			assert.Equal(t, tc.valid, !resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestDriverTargetRegexp(t *testing.T) {
+	tests := []struct {
+		target string
+		valid  bool
+	}{
+		{target: "https://drivers.example.com/s3/", valid: true},
+		{target: "driver://humanitec/static", valid: true},
+		{target: "http://drivers.example.com/s3/", valid: false},
+		{target: "driver://humanitec", valid: false},
+		{target: "driver://humanitec/static/extra", valid: false},
+		{target: "drivers.example.com/s3/", valid: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.target, func(t *testing.T) {
+			assert.Equal(t, tc.valid, driverTargetRegexp.MatchString(tc.target))
+		})
+	}
+}
+
 func testAccResourceResourceDriver(id, target string) string {
 	return fmt.Sprintf(`
 resource "humanitec_resource_driver" "s3" {
@@ -161,3 +220,22 @@ resource "humanitec_resource_driver" "s3" {
 }
 `, id, target)
 }
+
+func testAccResourceResourceDriverVirtualYAML(id, templateType string) string {
+	return fmt.Sprintf(`
+resource "humanitec_resource_driver" "s3" {
+	id   = "%s"
+	type = "s3"
+
+	account_types = [
+		"aws",
+	]
+
+	inputs_schema = jsonencode({})
+	target        = "driver://humanitec/static"
+	template = <<EOT
+type: %s
+EOT
+}
+`, id, templateType)
+}
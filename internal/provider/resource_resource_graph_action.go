@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/hashcode"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ResourceResourceGraphAction{}
+
+func NewResourceResourceGraphAction() resource.Resource {
+	return &ResourceResourceGraphAction{}
+}
+
+// ResourceResourceGraphAction defines the resource implementation.
+type ResourceResourceGraphAction struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// ResourceGraphActionModel describes the resource graph action data model.
+type ResourceGraphActionModel struct {
+	ID           types.String `tfsdk:"id"`
+	DefinitionID types.String `tfsdk:"definition_id"`
+	Triggers     types.Map    `tfsdk:"triggers"`
+	Comment      types.String `tfsdk:"comment"`
+	Deployments  types.List   `tfsdk:"deployments"`
+}
+
+// ResourceGraphActionDeploymentModel describes a deployment started by the action.
+type ResourceGraphActionDeploymentModel struct {
+	AppID types.String `tfsdk:"app_id"`
+	EnvID types.String `tfsdk:"env_id"`
+	ID    types.String `tfsdk:"id"`
+}
+
+var resourceGraphActionDeploymentAttrTypes = map[string]attr.Type{
+	"app_id": types.StringType,
+	"env_id": types.StringType,
+	"id":     types.StringType,
+}
+
+func (r *ResourceResourceGraphAction) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_graph_action"
+}
+
+func (r *ResourceResourceGraphAction) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Re-provisions the Active Resources of a Resource Definition by redeploying every Environment using them with its latest Deployment Set, e.g. to roll out rotated credentials in the same apply.
+
+The redeployments are started when the resource is created or any of its attributes change, use ` + "`triggers`" + ` to start them again. Destroying the resource doesn't revert them.
+
+If an Environment fails to redeploy, the others are still redeployed and the started Deployments are kept in the state. The resource is tainted and the next apply starts the redeployments again.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifies the started Deployments.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"definition_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Resource Definition whose Active Resources are re-provisioned.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that re-provision the Active Resources again when changed, e.g. the version of rotated credentials.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "A comment for the Deployments.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deployments": schema.ListAttribute{
+				MarkdownDescription: "The started Deployments with their `app_id`, `env_id` and `id`.",
+				ElementType: types.ObjectType{
+					AttrTypes: resourceGraphActionDeploymentAttrTypes,
+				},
+				Computed: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ResourceResourceGraphAction) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	r.client = resdata.Client
+	r.orgId = resdata.OrgID
+}
+
+// appEnv identifies an Environment of an Application.
+type appEnv struct {
+	AppID string
+	EnvID string
+}
+
+// activeResourceEnvironments returns the distinct environments of the active resources, sorted by app and env id.
+func activeResourceEnvironments(resources []client.ActiveResourceResponse) []appEnv {
+	seen := map[appEnv]bool{}
+	envs := []appEnv{}
+	for _, res := range resources {
+		env := appEnv{AppID: res.AppId, EnvID: res.EnvId}
+		if seen[env] {
+			continue
+		}
+		seen[env] = true
+		envs = append(envs, env)
+	}
+
+	sort.Slice(envs, func(i, j int) bool {
+		if envs[i].AppID != envs[j].AppID {
+			return envs[i].AppID < envs[j].AppID
+		}
+		return envs[i].EnvID < envs[j].EnvID
+	})
+	return envs
+}
+
+func (r *ResourceResourceGraphAction) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ResourceGraphActionModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defID := data.DefinitionID.ValueString()
+
+	activeResp, err := r.client.ListActiveResourceByDefinitionWithResponse(ctx, r.orgId, defID)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list active resources, got error: %s", err))
+		return
+	}
+	if activeResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list active resources, unexpected status code: %d, body: %s", activeResp.StatusCode(), activeResp.Body))
+		return
+	}
+
+	var activeResources []client.ActiveResourceResponse
+	if activeResp.JSON200 != nil {
+		activeResources = *activeResp.JSON200
+	}
+
+	comment := data.Comment.ValueString()
+	if comment == "" {
+		comment = fmt.Sprintf("Re-provision active resources of resource definition %s", defID)
+	}
+
+	// Every Environment is redeployed even if others fail, the started deployments can't be reverted and are kept in the state
+	deploymentIDs := []string{}
+	deployments := []ResourceGraphActionDeploymentModel{}
+	for _, env := range activeResourceEnvironments(activeResources) {
+		deploymentID, diags := r.redeployEnvironment(ctx, env, defID, comment)
+		resp.Diagnostics.Append(diags...)
+		if deploymentID == "" {
+			continue
+		}
+
+		deploymentIDs = append(deploymentIDs, fmt.Sprintf("%s/%s/%s", env.AppID, env.EnvID, deploymentID))
+		deployments = append(deployments, ResourceGraphActionDeploymentModel{
+			AppID: types.StringValue(env.AppID),
+			EnvID: types.StringValue(env.EnvID),
+			ID:    types.StringValue(deploymentID),
+		})
+	}
+
+	deploymentList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: resourceGraphActionDeploymentAttrTypes}, deployments)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(hashcode.Strings(append([]string{defID}, deploymentIDs...)))
+	data.Deployments = deploymentList
+
+	// Save data into Terraform state, Terraform taints the resource if an Environment failed to redeploy
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// redeployEnvironment redeploys the latest deployment set of an environment and returns the id of the started deployment,
+// which is empty if the environment wasn't redeployed.
+func (r *ResourceResourceGraphAction) redeployEnvironment(ctx context.Context, env appEnv, defID, comment string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	listDeploymentsResp, err := r.client.ListDeploymentsWithResponse(ctx, r.orgId, env.AppID, env.EnvID, &client.ListDeploymentsParams{})
+	if err != nil {
+		diags.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list deployments of environment %s/%s, got error: %s", env.AppID, env.EnvID, err))
+		return "", diags
+	}
+	if listDeploymentsResp.StatusCode() != http.StatusOK {
+		diags.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list deployments of environment %s/%s, unexpected status code: %d, body: %s", env.AppID, env.EnvID, listDeploymentsResp.StatusCode(), listDeploymentsResp.Body))
+		return "", diags
+	}
+
+	latest, ok := latestDeployment(listDeploymentsResp.JSON200)
+	if !ok {
+		diags.AddWarning("Environment not redeployed", fmt.Sprintf("Environment %s/%s has active resources of resource definition %s, but no deployment to redeploy", env.AppID, env.EnvID, defID))
+		return "", diags
+	}
+
+	setID := latest.SetId
+	createDeploymentResp, err := r.client.CreateDeploymentWithResponse(ctx, r.orgId, env.AppID, env.EnvID, client.DeploymentRequest{
+		SetId:   &setID,
+		Comment: &comment,
+	})
+	if err != nil {
+		diags.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to redeploy environment %s/%s, got error: %s", env.AppID, env.EnvID, err))
+		return "", diags
+	}
+	if createDeploymentResp.StatusCode() != http.StatusCreated || createDeploymentResp.JSON201 == nil {
+		diags.AddError(HUM_API_ERR, fmt.Sprintf("Unable to redeploy environment %s/%s, unexpected status code: %d, body: %s", env.AppID, env.EnvID, createDeploymentResp.StatusCode(), createDeploymentResp.Body))
+		return "", diags
+	}
+
+	return createDeploymentResp.JSON201.Id, diags
+}
+
+func (r *ResourceResourceGraphAction) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The redeployments are a one-off action, there is nothing to refresh.
+}
+
+func (r *ResourceResourceGraphAction) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("UNSUPPORTED_OPERATION", "Updating a resource graph action is not supported")
+}
+
+func (r *ResourceResourceGraphAction) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Deployments can't be reverted, removing the resource from the state is sufficient.
+}
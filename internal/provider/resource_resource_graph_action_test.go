@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/humanitec/humanitec-go-autogen/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActiveResourceEnvironments(t *testing.T) {
+	resources := []client.ActiveResourceResponse{
+		{AppId: "web", EnvId: "production", ResId: "modules.api.externals.db"},
+		{AppId: "api", EnvId: "staging", ResId: "shared.db"},
+		{AppId: "web", EnvId: "production", ResId: "modules.worker.externals.db"},
+		{AppId: "api", EnvId: "development", ResId: "shared.db"},
+	}
+
+	assert.Equal(t, []appEnv{
+		{AppID: "api", EnvID: "development"},
+		{AppID: "api", EnvID: "staging"},
+		{AppID: "web", EnvID: "production"},
+	}, activeResourceEnvironments(resources))
+
+	assert.Equal(t, []appEnv{}, activeResourceEnvironments(nil))
+}
+
+func TestResourceGraphActionCreatePartialFailure(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /orgs/test-org/resources/defs/postgres/resources":
+			assert.NoError(json.NewEncoder(w).Encode([]client.ActiveResourceResponse{
+				{AppId: "api", EnvId: "development", DefId: "postgres"},
+				{AppId: "web", EnvId: "production", DefId: "postgres"},
+			}))
+		case "GET /orgs/test-org/apps/api/envs/development/deploys", "GET /orgs/test-org/apps/web/envs/production/deploys":
+			assert.NoError(json.NewEncoder(w).Encode([]client.DeploymentResponse{
+				{Id: "previous", SetId: "set-id", CreatedAt: time.Now()},
+			}))
+		case "POST /orgs/test-org/apps/api/envs/development/deploys":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error": "internal error"}`))
+		case "POST /orgs/test-org/apps/web/envs/production/deploys":
+			w.WriteHeader(http.StatusCreated)
+			assert.NoError(json.NewEncoder(w).Encode(client.DeploymentResponse{Id: "started", SetId: "set-id"}))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	humClient, err := NewHumanitecClient(srv.URL, "TEST_TOKEN", "test", &http.Client{})
+	assert.NoError(err)
+
+	r := &ResourceResourceGraphAction{client: humClient, orgId: "test-org"}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	assert.False(schemaResp.Diagnostics.HasError())
+
+	emptyValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: emptyValue}
+	assert.False(plan.Set(ctx, &ResourceGraphActionModel{
+		ID:           types.StringUnknown(),
+		DefinitionID: types.StringValue("postgres"),
+		Triggers:     types.MapNull(types.StringType),
+		Comment:      types.StringNull(),
+		Deployments:  types.ListUnknown(types.ObjectType{AttrTypes: resourceGraphActionDeploymentAttrTypes}),
+	}).HasError())
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: emptyValue}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+	// The failure of one environment doesn't stop redeploying the others
+	assert.Equal([]string{
+		"GET /orgs/test-org/resources/defs/postgres/resources",
+		"GET /orgs/test-org/apps/api/envs/development/deploys",
+		"POST /orgs/test-org/apps/api/envs/development/deploys",
+		"GET /orgs/test-org/apps/web/envs/production/deploys",
+		"POST /orgs/test-org/apps/web/envs/production/deploys",
+	}, requests)
+	assert.Equal(1, resp.Diagnostics.ErrorsCount())
+	assert.Contains(resp.Diagnostics[0].Detail(), "Unable to redeploy environment api/development")
+
+	// The started deployments are kept in the state
+	var data *ResourceGraphActionModel
+	assert.False(resp.State.Get(ctx, &data).HasError())
+	if !assert.NotNil(data) {
+		return
+	}
+	var deployments []ResourceGraphActionDeploymentModel
+	assert.False(data.Deployments.ElementsAs(ctx, &deployments, false).HasError())
+	assert.Equal([]ResourceGraphActionDeploymentModel{
+		{AppID: types.StringValue("web"), EnvID: types.StringValue("production"), ID: types.StringValue("started")},
+	}, deployments)
+	assert.False(data.ID.IsUnknown())
+}
@@ -3,7 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -21,6 +21,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &ResourceRule{}
 var _ resource.ResourceWithImportState = &ResourceRule{}
+var _ resource.ResourceWithModifyPlan = &ResourceRule{}
 
 func NewResourceRule() resource.Resource {
 	return &ResourceRule{}
@@ -30,6 +31,7 @@ func NewResourceRule() resource.Resource {
 type ResourceRule struct {
 	client *humanitec.Client
 	orgId  string
+	data   *HumanitecData
 }
 
 // RuleModel describes the app data model.
@@ -43,6 +45,7 @@ type RuleModel struct {
 	ExcludeArtefactsFilter types.Bool     `tfsdk:"exclude_artefacts_filter"`
 	MatchRef               types.String   `tfsdk:"match_ref"`
 	Type                   types.String   `tfsdk:"type"`
+	CreatedAt              types.String   `tfsdk:"created_at"`
 }
 
 func (r *ResourceRule) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -97,29 +100,36 @@ func (r *ResourceRule) Schema(ctx context.Context, req resource.SchemaRequest, r
 				MarkdownDescription: "Specifies the type of event. Currently, only updates to either branches or tags are supported. Must be `update`.",
 				Required:            true,
 			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp in UTC of when the Rule was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
 
 func (r *ResourceRule) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
 		return
 	}
 
-	resdata, ok := req.ProviderData.(*HumanitecData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
 		return
 	}
 
 	r.client = resdata.Client
 	r.orgId = resdata.OrgID
+	r.data = resdata
+}
+
+func (r *ResourceRule) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.data.validateAppReference(ctx, req, resp)
 }
 
 func parseAutomationRuleResponse(res *client.AutomationRuleResponse, data *RuleModel) {
@@ -135,6 +145,7 @@ func parseAutomationRuleResponse(res *client.AutomationRuleResponse, data *RuleM
 	data.ExcludeArtefactsFilter = types.BoolValue(res.ExcludeArtefactsFilter)
 	data.MatchRef = types.StringValue(res.MatchRef)
 	data.Type = types.StringValue(res.Type)
+	data.CreatedAt = types.StringValue(res.CreatedAt.Format(time.RFC3339))
 }
 
 func toAutomationRuleRequest(data *RuleModel) (*client.AutomationRuleRequest, diag.Diagnostics) {
@@ -288,14 +299,14 @@ func (r *ResourceRule) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *ResourceRule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
+	idParts := splitImportID(req.ID)
 
 	// ensure idParts elements are not empty
 	for _, idPart := range idParts {
 		if idPart == "" {
 			resp.Diagnostics.AddError(
 				"Unexpected Import Identifier",
-				fmt.Sprintf("Expected import identifier with format: app_id/env_id/rule_id. Got: %q", req.ID),
+				fmt.Sprintf("Expected import identifier with format: app_id/env_id/rule_id, or app_id::env_id::rule_id for ids containing slashes. Got: %q", req.ID),
 			)
 			return
 		}
@@ -308,7 +319,7 @@ func (r *ResourceRule) ImportState(ctx context.Context, req resource.ImportState
 	} else {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: app_id/env_id/rule_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: app_id/env_id/rule_id, or app_id::env_id::rule_id for ids containing slashes. Got: %q", req.ID),
 		)
 		return
 	}
@@ -37,7 +37,7 @@ func TestAccResourceRule(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			appId := fmt.Sprintf("tf-rule-%d", time.Now().UnixNano())
 
-			resource.Test(t, resource.TestCase{
+			testAccTest(t, resource.TestCase{
 				PreCheck:                 func() { testAccPreCheck(t) },
 				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 				Steps: []resource.TestStep{
@@ -46,6 +46,7 @@ func TestAccResourceRule(t *testing.T) {
 						Config: tc.config(appId, "my-artefact"),
 						Check: resource.ComposeAggregateTestCheckFunc(
 							resource.TestCheckResourceAttr("humanitec_rule.rule1", "artefacts_filter.0", "my-artefact"),
+							resource.TestCheckResourceAttrSet("humanitec_rule.rule1", "created_at"),
 						),
 					},
 					// ImportState testing
@@ -84,20 +85,16 @@ func TestAccResourceRule_DeletedManually(t *testing.T) {
 
 	orgID := os.Getenv("HUMANITEC_ORG")
 	token := os.Getenv("HUMANITEC_TOKEN")
-	apiHost := os.Getenv("HUMANITEC_HOST")
-	if apiHost == "" {
-		apiHost = humanitec.DefaultAPIHost
-	}
 
 	var client *humanitec.Client
 	var err error
 	var id string
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
 
-			client, err = NewHumanitecClient(apiHost, token, "test", nil)
+			client, err = NewHumanitecClient(testAccAPIPrefix(), token, "test", nil)
 			assert.NoError(err)
 		},
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
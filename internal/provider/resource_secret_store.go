@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -38,6 +39,8 @@ type SecretStoreModel struct {
 	AzureKV *AzureKVModel `tfsdk:"azurekv"`
 	GcpSM   *GcpSMModel   `tfsdk:"gcpsm"`
 	Vault   *VaultModel   `tfsdk:"vault"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type AwsSMModel struct {
@@ -124,6 +127,7 @@ func (*SecretStore) Schema(ctx context.Context, req resource.SchemaRequest, resp
 							"secret_access_key": schema.StringAttribute{
 								MarkdownDescription: "The Secret Access Key.",
 								Required:            true,
+								Sensitive:           true,
 							},
 						},
 					},
@@ -153,6 +157,7 @@ func (*SecretStore) Schema(ctx context.Context, req resource.SchemaRequest, resp
 							"client_secret": schema.StringAttribute{
 								MarkdownDescription: "The AzureKV Client Secret.",
 								Required:            true,
+								Sensitive:           true,
 							},
 						},
 					},
@@ -174,6 +179,7 @@ func (*SecretStore) Schema(ctx context.Context, req resource.SchemaRequest, resp
 							"secret_access_key": schema.StringAttribute{
 								MarkdownDescription: "The Secret Access Key.",
 								Required:            true,
+								Sensitive:           true,
 							},
 						},
 					},
@@ -203,6 +209,7 @@ func (*SecretStore) Schema(ctx context.Context, req resource.SchemaRequest, resp
 							"token": schema.StringAttribute{
 								MarkdownDescription: "Token to access Vault.",
 								Optional:            true,
+								Sensitive:           true,
 							},
 							"role": schema.StringAttribute{
 								MarkdownDescription: "Role to assume to access Vault.",
@@ -212,24 +219,25 @@ func (*SecretStore) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					},
 				},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 func (s *SecretStore) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
 		return
 	}
 
-	resdata, ok := req.ProviderData.(*HumanitecData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
 		return
 	}
 
@@ -343,6 +351,12 @@ func (s *SecretStore) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	httpBody, diags := toSecretStoreRequest(data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -375,6 +389,12 @@ func (s *SecretStore) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := data.ID.ValueString()
 
 	httpResp, err := s.client.GetOrgsOrgIdSecretstoresStoreIdWithResponse(ctx, s.orgId, id)
@@ -416,6 +436,12 @@ func (s *SecretStore) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 
 	createBody, diags := toSecretStoreRequest(data)
@@ -462,6 +488,12 @@ func (s *SecretStore) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := data.ID.ValueString()
 
 	httpResp, err := s.client.DeleteOrgsOrgIdSecretstoresStoreIdWithResponse(ctx, s.orgId, id)
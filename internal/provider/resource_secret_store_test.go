@@ -17,7 +17,7 @@ func TestAccResourceSecretStore_AzureKV(t *testing.T) {
 	id := fmt.Sprintf("azurekv-test-%d", time.Now().UnixNano())
 	newId := fmt.Sprintf("azurekv-test-new-%d", time.Now().UnixNano())
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -70,7 +70,7 @@ func TestAccResourceSecretStore_AzureKV(t *testing.T) {
 func TestAccResourceSecretStore_Aws(t *testing.T) {
 	id := fmt.Sprintf("awssm-test-%d", time.Now().UnixNano())
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -110,7 +110,7 @@ func TestAccResourceSecretStore_Aws(t *testing.T) {
 func TestAccResourceSecretStore_GcpSM(t *testing.T) {
 	id := fmt.Sprintf("gcpsm-test-%d", time.Now().UnixNano())
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -148,7 +148,7 @@ func TestAccResourceSecretStore_GcpSM(t *testing.T) {
 func TestAccResourceSecretStore_Vault(t *testing.T) {
 	id := fmt.Sprintf("vault-test-%d", time.Now().UnixNano())
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -188,7 +188,7 @@ func TestAccResourceSecretStore_Vault(t *testing.T) {
 func TestAccResourceSecretStore_Vault_RemoveAuth(t *testing.T) {
 	id := fmt.Sprintf("vault-test-%d", time.Now().UnixNano())
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -232,19 +232,15 @@ func TestAccResourceSecretStore_DeletedManually(t *testing.T) {
 
 	orgID := os.Getenv("HUMANITEC_ORG")
 	token := os.Getenv("HUMANITEC_TOKEN")
-	apiHost := os.Getenv("HUMANITEC_HOST")
-	if apiHost == "" {
-		apiHost = humanitec.DefaultAPIHost
-	}
 
 	var client *humanitec.Client
 	var err error
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
 
-			client, err = NewHumanitecClient(apiHost, token, "test", nil)
+			client, err = NewHumanitecClient(testAccAPIPrefix(), token, "test", nil)
 			assert.NoError(err)
 		},
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -16,7 +16,7 @@ func TestAccResourceServiceUserToken(t *testing.T) {
 	)
 	expiresAt := time.Now().Add(24 * time.Hour).Format("2006-01-02T15:04:05Z")
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -15,7 +15,7 @@ func TestAccResourceUser(t *testing.T) {
 		newRole  = "administrator"
 		userType = "service"
 	)
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -3,26 +3,36 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/humanitec/humanitec-go-autogen"
 	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/convert"
+	"github.com/humanitec/terraform-provider-humanitec/internal/pagination"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &ResourceValue{}
 var _ resource.ResourceWithImportState = &ResourceValue{}
+var _ resource.ResourceWithModifyPlan = &ResourceValue{}
 
 func NewResourceValue() resource.Resource {
 	return &ResourceValue{}
@@ -32,6 +42,7 @@ func NewResourceValue() resource.Resource {
 type ResourceValue struct {
 	client *humanitec.Client
 	orgId  string
+	data   *HumanitecData
 }
 
 // ValueModel describes the app data model.
@@ -40,11 +51,17 @@ type ValueModel struct {
 	AppID types.String `tfsdk:"app_id"`
 	EnvID types.String `tfsdk:"env_id"`
 
-	Key         types.String `tfsdk:"key"`
-	Description types.String `tfsdk:"description"`
-	IsSecret    types.Bool   `tfsdk:"is_secret"`
-	Value       types.String `tfsdk:"value"`
-	SecretRef   types.Object `tfsdk:"secret_ref"`
+	Key           types.String `tfsdk:"key"`
+	Description   types.String `tfsdk:"description"`
+	IsSecret      types.Bool   `tfsdk:"is_secret"`
+	Value         types.String `tfsdk:"value"`
+	ValueJSON     types.String `tfsdk:"value_json"`
+	SensitiveJSON types.String `tfsdk:"sensitive_json"`
+	SecretRef     types.Object `tfsdk:"secret_ref"`
+
+	PreventDestroySecret types.Bool `tfsdk:"prevent_destroy_secret"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // SecretRef describes a secret reference that might contain a secret value or a reference to an already stored secret.
@@ -64,6 +81,9 @@ func SecretRefAttributeTypes() map[string]attr.Type {
 	}
 }
 
+// valueReplaceAttributes are the attributes replacing the Shared Value when changed.
+var valueReplaceAttributes = path.Paths{path.Root("app_id"), path.Root("env_id"), path.Root("key"), path.Root("is_secret")}
+
 func (r *ResourceValue) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_value"
 }
@@ -102,7 +122,9 @@ func (r *ResourceValue) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "A Human friendly description of what the Shared Value is.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
 			},
 			"is_secret": schema.BoolAttribute{
 				MarkdownDescription: "Specified that the Shared Value contains a secret.",
@@ -116,6 +138,24 @@ func (r *ResourceValue) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"value_json": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded value that will be stored in its normalized form, the value read back is only updated if it isn't semantically equal. It can't be defined if value, sensitive_json or secret_ref is defined.",
+				Optional:            true,
+				Validators: []validator.String{
+					jsonValidator{},
+					stringvalidator.ConflictsWith(path.MatchRoot("value"), path.MatchRoot("sensitive_json"), path.MatchRoot("secret_ref")),
+				},
+			},
+			"sensitive_json": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded value that will be stored in its normalized form in the primary organization store, like `secret_ref.value`. It can't be defined if is_secret is false or value or secret_ref is defined.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					jsonValidator{},
+					isSecretValidator{},
+					stringvalidator.ConflictsWith(path.MatchRoot("value"), path.MatchRoot("secret_ref")),
+				},
+			},
 			"secret_ref": schema.SingleNestedAttribute{
 				MarkdownDescription: "The sensitive value that will be stored in the primary organization store or a reference to a sensitive value already stored in one of the registered stores. It can't be defined if is_secret is false or value is defined.",
 				Optional:            true,
@@ -143,29 +183,42 @@ func (r *ResourceValue) Schema(ctx context.Context, req resource.SchemaRequest,
 					},
 				},
 			},
+			"prevent_destroy_secret": schema.BoolAttribute{
+				MarkdownDescription: "Fail plans that destroy or replace the Shared Value while it contains a secret, unless `allow_force_delete` is set in the provider configuration. Every secret value destroyed by a plan is listed in a warning regardless of this flag.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 func (r *ResourceValue) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
 		return
 	}
 
-	resdata, ok := req.ProviderData.(*HumanitecData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
 		return
 	}
 
 	r.client = resdata.Client
 	r.orgId = resdata.OrgID
+	r.data = resdata
+}
+
+func (r *ResourceValue) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.data.validateAppReference(ctx, req, resp)
+	r.data.validateSecretValueDestroy(ctx, req, resp, valueReplaceAttributes)
 }
 
 func envValueIdPrefix(appID, envID string) string {
@@ -178,7 +231,14 @@ func parseValueResponse(ctx context.Context, res *client.ValueResponse, data *Va
 	data.Description = types.StringValue(res.Description)
 	data.IsSecret = types.BoolValue(res.IsSecret)
 	if !res.IsSecret {
-		data.Value = types.StringValue(res.Value)
+		if !data.ValueJSON.IsNull() && !data.ValueJSON.IsUnknown() {
+			// Keep the configured formatting as long as the stored value is equivalent
+			if !jsonEqual(data.ValueJSON.ValueString(), res.Value) {
+				data.ValueJSON = types.StringValue(res.Value)
+			}
+		} else {
+			data.Value = types.StringValue(res.Value)
+		}
 		data.SecretRef = basetypes.NewObjectNull(SecretRefAttributeTypes())
 	} else {
 		var secretRef SecretRef
@@ -207,6 +267,69 @@ func parseValueResponse(ctx context.Context, res *client.ValueResponse, data *Va
 	}
 }
 
+// jsonValuePayload returns the normalized value_json as value or sensitive_json as secret reference value.
+func jsonValuePayload(data *ValueModel) (*string, *client.SecretReference, error) {
+	if !data.SensitiveJSON.IsNull() {
+		normalized, err := normalizeJSON(data.SensitiveJSON.ValueString())
+		if err != nil {
+			return nil, nil, fmt.Errorf("sensitive_json is not valid JSON: %w", err)
+		}
+		return nil, &client.SecretReference{Value: &normalized}, nil
+	}
+
+	normalized, err := normalizeJSON(data.ValueJSON.ValueString())
+	if err != nil {
+		return nil, nil, fmt.Errorf("value_json is not valid JSON: %w", err)
+	}
+	return &normalized, nil, nil
+}
+
+// jsonValidator ensures a string is valid JSON.
+type jsonValidator struct{}
+
+func (v jsonValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v jsonValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be valid JSON"
+}
+
+func (v jsonValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := normalizeJSON(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, HUM_INPUT_ERR, fmt.Sprintf("Value is not valid JSON: %s", err))
+	}
+}
+
+// isSecretValidator ensures a string is only defined if is_secret is true.
+type isSecretValidator struct{}
+
+func (v isSecretValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v isSecretValidator) MarkdownDescription(_ context.Context) string {
+	return "value can only be defined if is_secret is true"
+}
+
+func (v isSecretValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+
+	var isSecret types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("is_secret"), &isSecret)...)
+	if resp.Diagnostics.HasError() || isSecret.IsNull() || isSecret.IsUnknown() || isSecret.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(req.Path, HUM_INPUT_ERR, fmt.Sprintf("%s can only be defined if is_secret is true, use value_json for values that aren't secret.", req.Path))
+}
+
 func (r *ResourceValue) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *ValueModel
 
@@ -217,6 +340,12 @@ func (r *ResourceValue) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	appID := data.AppID.ValueString()
 	key := data.Key.ValueString()
 
@@ -227,7 +356,15 @@ func (r *ResourceValue) Create(ctx context.Context, req resource.CreateRequest,
 		Description: data.Description.ValueStringPointer(),
 		IsSecret:    data.IsSecret.ValueBoolPointer(),
 	}
-	if !data.Value.IsNull() {
+	if !data.ValueJSON.IsNull() || !data.SensitiveJSON.IsNull() {
+		value, secretRef, err := jsonValuePayload(data)
+		if err != nil {
+			resp.Diagnostics.AddError(HUM_INPUT_ERR, err.Error())
+			return
+		}
+		createPayload.Value = value
+		createPayload.SecretRef = secretRef
+	} else if !data.Value.IsNull() {
 		createPayload.Value = data.Value.ValueStringPointer()
 	} else {
 		var secretRef SecretRef
@@ -296,44 +433,58 @@ func (r *ResourceValue) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	appID := data.AppID.ValueString()
 
-	var res *[]client.ValueResponse
 	var idPrefix string
+	var listValues pagination.Page[client.ValueResponse]
 	if data.EnvID.IsNull() {
-		httpResp, err := r.client.GetOrgsOrgIdAppsAppIdValuesWithResponse(ctx, r.orgId, appID)
-		if err != nil {
-			resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to read value, got error: %s", err))
-			return
-		}
-
-		if httpResp.StatusCode() != 200 {
-			resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to read value, unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body))
-			return
+		listValues = func(ctx context.Context, editor pagination.RequestEditor) ([]client.ValueResponse, *http.Response, error) {
+			httpResp, err := r.client.GetOrgsOrgIdAppsAppIdValuesWithResponse(ctx, r.orgId, appID, editor)
+			if err != nil {
+				return nil, nil, err
+			}
+			if httpResp.StatusCode() != 200 {
+				return nil, nil, fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+			}
+			if httpResp.JSON200 == nil {
+				return nil, httpResp.HTTPResponse, nil
+			}
+			return *httpResp.JSON200, httpResp.HTTPResponse, nil
 		}
-
-		res = httpResp.JSON200
 		idPrefix = appID
 	} else {
 		envID := data.EnvID.ValueString()
-		httpResp, err := r.client.GetOrgsOrgIdAppsAppIdEnvsEnvIdValuesWithResponse(ctx, r.orgId, appID, envID)
-		if err != nil {
-			resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to read value, got error: %s", err))
-			return
-		}
-
-		if httpResp.StatusCode() != 200 {
-			resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to read value, unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body))
-			return
+		listValues = func(ctx context.Context, editor pagination.RequestEditor) ([]client.ValueResponse, *http.Response, error) {
+			httpResp, err := r.client.GetOrgsOrgIdAppsAppIdEnvsEnvIdValuesWithResponse(ctx, r.orgId, appID, envID, editor)
+			if err != nil {
+				return nil, nil, err
+			}
+			if httpResp.StatusCode() != 200 {
+				return nil, nil, fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+			}
+			if httpResp.JSON200 == nil {
+				return nil, httpResp.HTTPResponse, nil
+			}
+			return *httpResp.JSON200, httpResp.HTTPResponse, nil
 		}
-
-		res = httpResp.JSON200
 		idPrefix = envValueIdPrefix(appID, envID)
 	}
 
+	res, err := pagination.All(ctx, listValues)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to read value, got error: %s", err))
+		return
+	}
+
 	// TODO Ideally the API should allow to fetch a value by KEY
 	key := data.Key.ValueString()
-	value, found := findInSlicePtr(res, func(a client.ValueResponse) bool {
+	value, found := convert.FindInSlicePtr(&res, func(a client.ValueResponse) bool {
 		return a.Key == key
 	})
 
@@ -344,6 +495,9 @@ func (r *ResourceValue) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	parseValueResponse(ctx, &value, data, idPrefix)
+	if data.PreventDestroySecret.IsNull() {
+		data.PreventDestroySecret = types.BoolValue(false)
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -358,6 +512,12 @@ func (r *ResourceValue) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var res *client.ValueResponse
 	var idPrefix string
 	appID := data.AppID.ValueString()
@@ -365,7 +525,15 @@ func (r *ResourceValue) Update(ctx context.Context, req resource.UpdateRequest,
 		Description: data.Description.ValueStringPointer(),
 		IsSecret:    data.IsSecret.ValueBoolPointer(),
 	}
-	if !data.Value.IsNull() {
+	if !data.ValueJSON.IsNull() || !data.SensitiveJSON.IsNull() {
+		value, secretRef, err := jsonValuePayload(data)
+		if err != nil {
+			resp.Diagnostics.AddError(HUM_INPUT_ERR, err.Error())
+			return
+		}
+		editPayload.Value = value
+		editPayload.SecretRef = secretRef
+	} else if !data.Value.IsNull() {
 		editPayload.Value = data.Value.ValueStringPointer()
 	} else {
 		var secretRef SecretRef
@@ -432,6 +600,12 @@ func (r *ResourceValue) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if data.EnvID.IsNull() {
 		httpResp, err := r.client.DeleteOrgsOrgIdAppsAppIdValuesKeyWithResponse(ctx, r.orgId, data.AppID.ValueString(), data.Key.ValueString())
 		if err != nil {
@@ -459,32 +633,39 @@ func (r *ResourceValue) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *ResourceValue) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
+	idParts, err := importIDParts(req.ID, r.orgId, "apps", "envs", "values")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Unable to parse import URL %q: %s", req.ID, err),
+		)
+		return
+	}
 
 	// ensure idParts elements are not empty
 	for _, idPart := range idParts {
 		if idPart == "" {
 			resp.Diagnostics.AddError(
 				"Unexpected Import Identifier",
-				fmt.Sprintf("Expected import identifier with format: app_id/key or app_id/env_id/key. Got: %q", req.ID),
+				fmt.Sprintf("Expected import identifier with format: app_id/key or app_id/env_id/key, app_id::key or app_id::env_id::key for keys containing slashes, or a Humanitec console URL. Got: %q", req.ID),
 			)
 			return
 		}
 	}
 
 	if len(idParts) == 2 {
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strings.Join(idParts, "/"))...)
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), idParts[0])...)
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), idParts[1])...)
 	} else if len(idParts) == 3 {
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strings.Join(idParts, "/"))...)
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), idParts[0])...)
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("env_id"), idParts[1])...)
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), idParts[2])...)
 	} else {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: app_id/key or app_id/env_id/key. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: app_id/key or app_id/env_id/key, app_id::key or app_id::env_id::key for keys containing slashes, or a Humanitec console URL. Got: %q", req.ID),
 		)
 		return
 	}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ResourceValueSetVersionRestore{}
+
+func NewResourceValueSetVersionRestore() resource.Resource {
+	return &ResourceValueSetVersionRestore{}
+}
+
+// ResourceValueSetVersionRestore defines the resource implementation.
+type ResourceValueSetVersionRestore struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// ValueSetVersionRestoreModel describes the value set version restore data model.
+type ValueSetVersionRestoreModel struct {
+	ID                types.String `tfsdk:"id"`
+	AppID             types.String `tfsdk:"app_id"`
+	EnvID             types.String `tfsdk:"env_id"`
+	ValueSetVersionID types.String `tfsdk:"value_set_version_id"`
+	Comment           types.String `tfsdk:"comment"`
+}
+
+func (r *ResourceValueSetVersionRestore) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_value_set_version_restore"
+}
+
+func (r *ResourceValueSetVersionRestore) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Restores the Shared Values of an Application or Environment to a previous Value Set Version.
+
+The restore is performed when the resource is created or any of its attributes change. Destroying the resource doesn't revert the restore.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Value Set Version created by the restore.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Application.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"env_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Environment. If not set, the Application level Shared Values are restored.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value_set_version_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Value Set Version to restore. Select it by a stable property, e.g. its `comment`, as the position of a version in `humanitec_value_set_versions` changes whenever values change, which would replace the restore.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "A comment describing the restore.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ResourceValueSetVersionRestore) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	r.client = resdata.Client
+	r.orgId = resdata.OrgID
+}
+
+func (r *ResourceValueSetVersionRestore) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ValueSetVersionRestoreModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := data.AppID.ValueString()
+	valueSetVersionID, err := uuid.Parse(data.ValueSetVersionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("value_set_version_id"), HUM_INPUT_ERR, fmt.Sprintf("Invalid value set version id: %s", err))
+		return
+	}
+
+	payload := client.ValueSetActionPayloadRequest{
+		Comment: data.Comment.ValueStringPointer(),
+	}
+
+	var res *client.ValueSetVersionResponse
+	if data.EnvID.IsNull() {
+		httpResp, err := r.client.PostOrgsOrgIdAppsAppIdValueSetVersionsValueSetVersionIdRestoreWithResponse(ctx, r.orgId, appID, valueSetVersionID, payload)
+		if err != nil {
+			resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to restore value set version, got error: %s", err))
+			return
+		}
+
+		if httpResp.StatusCode() != 200 {
+			resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to restore value set version, unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body))
+			return
+		}
+		res = httpResp.JSON200
+	} else {
+		httpResp, err := r.client.PostOrgsOrgIdAppsAppIdEnvsEnvIdValueSetVersionsValueSetVersionIdRestoreWithResponse(ctx, r.orgId, appID, data.EnvID.ValueString(), valueSetVersionID, payload)
+		if err != nil {
+			resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to restore value set version, got error: %s", err))
+			return
+		}
+
+		if httpResp.StatusCode() != 200 {
+			resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to restore value set version, unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body))
+			return
+		}
+		res = httpResp.JSON200
+	}
+
+	if res == nil {
+		resp.Diagnostics.AddError(HUM_API_ERR, "Unable to restore value set version, missing body")
+		return
+	}
+
+	data.ID = types.StringValue(res.Id)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ResourceValueSetVersionRestore) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// A restore is a one-off action, there is nothing to refresh.
+}
+
+func (r *ResourceValueSetVersionRestore) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("UNSUPPORTED_OPERATION", "Updating a value set version restore is not supported")
+}
+
+func (r *ResourceValueSetVersionRestore) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Restores can't be reverted, removing the resource from the state is sufficient.
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccResourceValueSetVersionRestore(t *testing.T) {
+	appID := fmt.Sprintf("val-restore-test-app-%d", time.Now().UnixNano())
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create an environment value with two versions
+			{
+				Config: testAccResourceValueSetVersionRestoreConfig(appID, "v1", false),
+			},
+			{
+				Config: testAccResourceValueSetVersionRestoreConfig(appID, "v2", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("humanitec_value.test", "value", "v2"),
+				),
+			},
+			// Restore the initial version
+			{
+				Config: testAccResourceValueSetVersionRestoreConfig(appID, "v2", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("humanitec_value_set_version_restore.test", "id"),
+					resource.TestCheckResourceAttr("humanitec_value_set_version_restore.test", "app_id", appID),
+					resource.TestCheckResourceAttr("humanitec_value_set_version_restore.test", "env_id", "development"),
+					resource.TestCheckResourceAttr("humanitec_value_set_version_restore.test", "comment", "Restore initial value"),
+					resource.TestCheckResourceAttrPair("humanitec_value_set_version_restore.test", "value_set_version_id", "data.humanitec_value_set_versions.test", "value_set_versions.1.id"),
+				),
+			},
+			// The restored value is refreshed without replacing the restore
+			{
+				Config: testAccResourceValueSetVersionRestoreConfig(appID, "v2", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("humanitec_value.test", "value", "v1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceValueSetVersionRestoreConfig(appID, value string, restore bool) string {
+	restoreConfig := ""
+	lifecycleConfig := ""
+	if restore {
+		restoreConfig = `
+resource "humanitec_value_set_version_restore" "test" {
+	app_id               = humanitec_application.test.id
+	env_id               = "development"
+	value_set_version_id = one([for v in data.humanitec_value_set_versions.test.value_set_versions : v.id if v.result_of == "env_value_create"])
+	comment              = "Restore initial value"
+}
+`
+		// The restore changes the value outside of its resource
+		lifecycleConfig = `
+	lifecycle {
+		ignore_changes = [value]
+	}
+`
+	}
+
+	return fmt.Sprintf(`
+resource "humanitec_application" "test" {
+	id   = "%s"
+	name = "%s"
+}
+
+resource "humanitec_value" "test" {
+	app_id      = humanitec_application.test.id
+	env_id      = "development"
+	key         = "VAL_RESTORE_TEST"
+	value       = "%s"
+	description = "value set version restore test"
+	is_secret   = false
+%s}
+
+data "humanitec_value_set_versions" "test" {
+	app_id      = humanitec_application.test.id
+	env_id      = "development"
+	key_changed = humanitec_value.test.key
+}
+%s
+`, appID, appID, value, lifecycleConfig, restoreConfig)
+}
+
+func TestValueSetVersionRestoreCreate(t *testing.T) {
+	versionID := "5c3b5a8e-6f1c-4a5e-9c1e-0f0b1c2d3e4f"
+
+	tests := []struct {
+		name  string
+		envID types.String
+		path  string
+	}{
+		{
+			name:  "application",
+			envID: types.StringNull(),
+			path:  "/orgs/test-org/apps/test-app/value-set-versions/" + versionID + "/restore",
+		},
+		{
+			name:  "environment",
+			envID: types.StringValue("development"),
+			path:  "/orgs/test-org/apps/test-app/envs/development/value-set-versions/" + versionID + "/restore",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			ctx := context.Background()
+
+			var requests []string
+			var body map[string]any
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				assert.NoError(json.NewDecoder(r.Body).Decode(&body))
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"id": "restored-version", "comment": "Restore initial value", "source_value_set_version_id": %q, "result_of": "app_value_set_version_restore", "values": {"KEY": {"key": "KEY", "value": "v1", "is_secret": false, "description": "", "source": "app", "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:00:00Z"}}, "change": [], "created_at": "2024-01-01T00:00:00Z", "created_by": "test-user", "updated_at": "2024-01-01T00:00:00Z"}`, versionID)
+			}))
+			defer srv.Close()
+
+			humClient, err := NewHumanitecClient(srv.URL, "TEST_TOKEN", "test", &http.Client{})
+			assert.NoError(err)
+
+			restore := &ResourceValueSetVersionRestore{client: humClient, orgId: "test-org"}
+
+			schemaResp := &fwresource.SchemaResponse{}
+			restore.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+			assert.False(schemaResp.Diagnostics.HasError())
+
+			emptyValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: emptyValue}
+			assert.False(plan.Set(ctx, &ValueSetVersionRestoreModel{
+				ID:                types.StringUnknown(),
+				AppID:             types.StringValue("test-app"),
+				EnvID:             tt.envID,
+				ValueSetVersionID: types.StringValue(versionID),
+				Comment:           types.StringValue("Restore initial value"),
+			}).HasError())
+
+			resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: emptyValue}}
+			restore.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+			assert.False(resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			assert.Equal([]string{"POST " + tt.path}, requests)
+			assert.Equal(map[string]any{"comment": "Restore initial value"}, body)
+
+			var restored *ValueSetVersionRestoreModel
+			assert.False(resp.State.Get(ctx, &restored).HasError())
+			assert.Equal(&ValueSetVersionRestoreModel{
+				ID:                types.StringValue("restored-version"),
+				AppID:             types.StringValue("test-app"),
+				EnvID:             tt.envID,
+				ValueSetVersionID: types.StringValue(versionID),
+				Comment:           types.StringValue("Restore initial value"),
+			}, restored)
+		})
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/humanitec/humanitec-go-autogen"
@@ -17,7 +20,7 @@ func TestAccResourceValue(t *testing.T) {
 	appID := fmt.Sprintf("val-test-app-%d", time.Now().UnixNano())
 	key := "VAL_1"
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -50,12 +53,70 @@ func TestAccResourceValue(t *testing.T) {
 	})
 }
 
+func TestAccResourceValueWithoutDescription(t *testing.T) {
+	appID := fmt.Sprintf("val-test-app-%d", time.Now().UnixNano())
+	key := "VAL_NO_DESCRIPTION"
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccResourceVALUETestAccResourceValueWithoutDescription(appID, key),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("humanitec_value.app_val1", "key", key),
+					resource.TestCheckResourceAttr("humanitec_value.app_val1", "description", ""),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "humanitec_value.app_val1",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s/%s", appID, key), nil
+				},
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccResourceValueWithValueJSON(t *testing.T) {
+	appID := fmt.Sprintf("val-test-app-%d", time.Now().UnixNano())
+	key := "VAL_JSON_1"
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccResourceVALUETestAccResourceValueJSON(appID, key, `jsonencode({ replicas = 2, tier = "gold" })`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("humanitec_value.app_val_json", "value_json", `{"replicas":2,"tier":"gold"}`),
+					resource.TestCheckNoResourceAttr("humanitec_value.app_val_json", "value"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccResourceVALUETestAccResourceValueJSON(appID, key, `jsonencode({ replicas = 3, tier = "gold" })`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("humanitec_value.app_val_json", "value_json", `{"replicas":3,"tier":"gold"}`),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func TestAccResourceValueWithSecretValue(t *testing.T) {
 	appID := fmt.Sprintf("val-test-app-%d", time.Now().UnixNano())
 	key := "VAL_SECRET_1"
 	orgID := os.Getenv("HUMANITEC_ORG")
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -99,7 +160,7 @@ func TestAccResourceValueWithSecretValueSecretRefValue(t *testing.T) {
 	key := "VAL_SECRET_REF_VALUE_1"
 	orgID := os.Getenv("HUMANITEC_ORG")
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -142,7 +203,7 @@ func TestAccResourceValueWithSecretRef(t *testing.T) {
 	key := "VAL_SECRET_REF_1"
 	orgID := os.Getenv("HUMANITEC_ORG")
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -197,19 +258,15 @@ func TestAccResourceValueDeletedOutManually(t *testing.T) {
 
 	orgID := os.Getenv("HUMANITEC_ORG")
 	token := os.Getenv("HUMANITEC_TOKEN")
-	apiHost := os.Getenv("HUMANITEC_HOST")
-	if apiHost == "" {
-		apiHost = humanitec.DefaultAPIHost
-	}
 
 	var client *humanitec.Client
 	var err error
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
 
-			client, err = NewHumanitecClient(apiHost, token, "test", nil)
+			client, err = NewHumanitecClient(testAccAPIPrefix(), token, "test", nil)
 			assert.NoError(err)
 		},
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -247,7 +304,7 @@ func TestAccResourceValueWithEnv(t *testing.T) {
 	envID := "dev"
 	key := "VAL_1"
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -299,6 +356,40 @@ resource "humanitec_value" "app_val1" {
 `, appID, key, description)
 }
 
+func testAccResourceVALUETestAccResourceValueJSON(appID, key, valueJSON string) string {
+	return fmt.Sprintf(`
+resource "humanitec_application" "val_test" {
+	id   = "%s"
+	name = "val-test"
+}
+
+resource "humanitec_value" "app_val_json" {
+	app_id = humanitec_application.val_test.id
+
+	key        = "%s"
+	value_json = %s
+	is_secret  = false
+}
+`, appID, key, valueJSON)
+}
+
+func testAccResourceVALUETestAccResourceValueWithoutDescription(appID, key string) string {
+	return fmt.Sprintf(`
+resource "humanitec_application" "val_test" {
+	id   = "%s"
+	name = "val-test"
+}
+
+resource "humanitec_value" "app_val1" {
+	app_id = humanitec_application.val_test.id
+
+	key       = "%s"
+	value     = "TEST"
+	is_secret = false
+}
+`, appID, key)
+}
+
 func testAccResourceVALUETestAccResourceValueWithEnv(appID, envID, key, description string) string {
 	return fmt.Sprintf(`
 resource "humanitec_application" "val_test" {
@@ -377,6 +468,55 @@ resource "humanitec_value" "app_val_with_secret" {
 `, appID, key, description)
 }
 
+func TestResourceValueSensitiveJSONRequiresSecret(t *testing.T) {
+	ctx := context.Background()
+
+	server := providerserver.NewProtocol6(New("test")())()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	assert.NoError(t, err)
+	valueType := schemaResp.ResourceSchemas["humanitec_value"].ValueType().(tftypes.Object)
+
+	tests := []struct {
+		name     string
+		isSecret tftypes.Value
+		details  []string
+	}{
+		{name: "secret", isSecret: tftypes.NewValue(tftypes.Bool, true)},
+		{name: "unknown", isSecret: tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue)},
+		{name: "not secret", isSecret: tftypes.NewValue(tftypes.Bool, false), details: []string{
+			"sensitive_json can only be defined if is_secret is true, use value_json for values that aren't secret.",
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := tfprotov6.NewDynamicValue(valueType, testObjectValue(valueType, map[string]tftypes.Value{
+				"app_id":         tftypes.NewValue(tftypes.String, "app"),
+				"key":            tftypes.NewValue(tftypes.String, "KEY"),
+				"is_secret":      tc.isSecret,
+				"sensitive_json": tftypes.NewValue(tftypes.String, `{"password": "secret"}`),
+			}))
+			assert.NoError(t, err)
+
+			resp, err := server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+				TypeName: "humanitec_value",
+				Config:   &config,
+			})
+			assert.NoError(t, err)
+
+			details := []string{}
+			for _, d := range resp.Diagnostics {
+				details = append(details, d.Detail)
+			}
+			if tc.details == nil {
+				assert.Empty(t, details)
+			} else {
+				assert.Equal(t, tc.details, details)
+			}
+		})
+	}
+}
+
 func testAccResourceVALUETestAccResourceValueSecretRefValue(appID, key, description string) string {
 	return fmt.Sprintf(`
 resource "humanitec_application" "val_test" {
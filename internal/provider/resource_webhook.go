@@ -3,8 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -14,16 +18,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/humanitec/humanitec-go-autogen"
 	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/convert"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &ResourceWebhook{}
 var _ resource.ResourceWithImportState = &ResourceWebhook{}
+var _ resource.ResourceWithModifyPlan = &ResourceWebhook{}
 
 func NewResourceWebhook() resource.Resource {
 	return &ResourceWebhook{}
@@ -33,6 +41,7 @@ func NewResourceWebhook() resource.Resource {
 type ResourceWebhook struct {
 	client *humanitec.Client
 	orgId  string
+	data   *HumanitecData
 }
 
 // WebhookModel describes the app data model.
@@ -51,6 +60,8 @@ type WebhookModel struct {
 	Payload  types.Map             `tfsdk:"payload"`
 	Triggers []WebhookTriggerModel `tfsdk:"triggers"`
 	URL      types.String          `tfsdk:"url"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ResourceWebhook) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -83,7 +94,7 @@ func (r *ResourceWebhook) Schema(ctx context.Context, req resource.SchemaRequest
 				Default:             booldefault.StaticBool(false),
 			},
 			"headers": schema.MapAttribute{
-				MarkdownDescription: "Custom webhook headers.",
+				MarkdownDescription: "Custom webhook headers. Names are compared case-insensitively, so the configured casing is kept when the API normalizes them.",
 				ElementType:         types.StringType,
 				Optional:            true,
 				Computed:            true,
@@ -96,56 +107,90 @@ func (r *ResourceWebhook) Schema(ctx context.Context, req resource.SchemaRequest
 			},
 			"triggers": schema.SetNestedAttribute{
 				MarkdownDescription: `
-A list of Events by which the Job is triggered, supported triggers are:
-
-  | scope | type |
-	|-------|------|
-	| environment  | created |
-	| environment  | deleted |
-	| deployment  | started |
-	| deployment  | finished |
-				`,
+A set of Events by which the Job is triggered, supported triggers are:
+
+| scope | type |
+|-------|------|
+| environment  | created |
+| environment  | deleted |
+| deployment  | started |
+| deployment  | finished |
+`,
 				Required: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"scope": schema.StringAttribute{
 							MarkdownDescription: "Scope of the trigger",
 							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(webhookTriggerScopes...),
+							},
 						},
 						"type": schema.StringAttribute{
 							MarkdownDescription: "Type of the trigger",
 							Required:            true,
 						},
 					},
+					Validators: []validator.Object{
+						webhookTriggerValidator{},
+					},
 				},
 			},
 			"url": schema.StringAttribute{
-				MarkdownDescription: "Thw webhook's URL (without protocol, only HTTPS is supported)",
+				MarkdownDescription: "The webhook's URL (only HTTPS is supported, the `https://` prefix is optional and stripped before it is sent to Humanitec)",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(webhookURLRegexp, "must be a HTTPS URL, only HTTPS is supported and the https:// prefix can be omitted"),
+				},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 func (r *ResourceWebhook) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
+		return
+	}
+
 	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	if resdata == nil {
 		return
 	}
 
-	resdata, ok := req.ProviderData.(*HumanitecData)
+	r.client = resdata.Client
+	r.orgId = resdata.OrgID
+	r.data = resdata
+}
+
+func (r *ResourceWebhook) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.data.validateAppReference(ctx, req, resp)
 
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+	// Skip destroy plans
+	if req.Plan.Raw.IsNull() {
+		return
+	}
 
+	var planURL, stateURL types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("url"), &planURL)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("url"), &stateURL)...)
+	}
+	if resp.Diagnostics.HasError() || planURL.IsUnknown() || planURL.Equal(stateURL) {
 		return
 	}
 
-	r.client = resdata.Client
-	r.orgId = resdata.OrgID
+	if url := planURL.ValueString(); normalizeWebhookURL(url) != url {
+		resp.Diagnostics.AddAttributeWarning(path.Root("url"), "Webhook URL prefix is stripped",
+			fmt.Sprintf("Humanitec expects webhook URLs without protocol, %q is sent as %q.", url, normalizeWebhookURL(url)))
+	}
 }
 
 func parseWebhookResponse(ctx context.Context, res *client.WebhookResponse, data *WebhookModel) diag.Diagnostics {
@@ -154,7 +199,7 @@ func parseWebhookResponse(ctx context.Context, res *client.WebhookResponse, data
 	data.ID = types.StringValue(res.Id)
 	data.Disabled = types.BoolPointerValue(res.Disabled)
 
-	headers, diag := types.MapValueFrom(ctx, types.StringType, res.Headers)
+	headers, diag := parseWebhookHeaders(ctx, res.Headers, data.Headers)
 	diags.Append(diag...)
 	data.Headers = headers
 
@@ -171,7 +216,7 @@ func parseWebhookResponse(ctx context.Context, res *client.WebhookResponse, data
 	}
 	data.Triggers = triggers
 
-	data.URL = types.StringPointerValue(res.Url)
+	data.URL = parseWebhookURL(res.Url, data.URL)
 
 	return diags
 }
@@ -181,7 +226,7 @@ func parseWebhookUpdateResponse(ctx context.Context, res *client.WebhookUpdateRe
 
 	data.Disabled = types.BoolPointerValue(res.Disabled)
 
-	headers, diag := types.MapValueFrom(ctx, types.StringType, res.Headers)
+	headers, diag := parseWebhookHeaders(ctx, res.Headers, data.Headers)
 	diags.Append(diag...)
 	data.Headers = headers
 
@@ -201,11 +246,107 @@ func parseWebhookUpdateResponse(ctx context.Context, res *client.WebhookUpdateRe
 	}
 	data.Triggers = triggers
 
-	data.URL = types.StringPointerValue(res.Url)
+	data.URL = parseWebhookURL(res.Url, data.URL)
 
 	return diags
 }
 
+// webhookTriggerScopes are the supported trigger scopes, webhookTriggerTypes the supported types per scope.
+var webhookTriggerScopes = []string{"environment", "deployment"}
+var webhookTriggerTypes = map[string][]string{
+	"environment": {"created", "deleted"},
+	"deployment":  {"started", "finished"},
+}
+
+// webhookTriggerValidator rejects triggers with a type that isn't supported for their scope.
+type webhookTriggerValidator struct{}
+
+func (v webhookTriggerValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v webhookTriggerValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("type must be supported by the scope, supported types per scope are %v", webhookTriggerTypes)
+}
+
+func (v webhookTriggerValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var trigger WebhookTriggerModel
+	resp.Diagnostics.Append(req.ConfigValue.As(ctx, &trigger, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if trigger.Scope.IsNull() || trigger.Scope.IsUnknown() || trigger.Type.IsNull() || trigger.Type.IsUnknown() {
+		return
+	}
+
+	// Unsupported scopes are reported by the scope attribute validator
+	supported, ok := webhookTriggerTypes[trigger.Scope.ValueString()]
+	if !ok {
+		return
+	}
+
+	if !slices.Contains(supported, trigger.Type.ValueString()) {
+		resp.Diagnostics.AddAttributeError(req.Path.AtName("type"), HUM_INPUT_ERR,
+			fmt.Sprintf("Trigger type %q is not supported for scope %q, supported types are %q.", trigger.Type.ValueString(), trigger.Scope.ValueString(), supported))
+	}
+}
+
+// normalizeWebhookURL strips the https:// prefix, which the API doesn't accept.
+func normalizeWebhookURL(url string) string {
+	return strings.TrimPrefix(url, "https://")
+}
+
+// parseWebhookURL keeps the configured URL when it only differs from the returned one by the stripped prefix.
+func parseWebhookURL(res *string, configured types.String) types.String {
+	if res != nil && !configured.IsNull() && !configured.IsUnknown() && normalizeWebhookURL(configured.ValueString()) == *res {
+		return configured
+	}
+	return types.StringPointerValue(res)
+}
+
+// parseWebhookHeaders keeps the configured headers when they only differ from the returned ones by the casing of the names,
+// which the API may normalize.
+func parseWebhookHeaders(ctx context.Context, res any, configured types.Map) (types.Map, diag.Diagnostics) {
+	headers, diags := types.MapValueFrom(ctx, types.StringType, res)
+	if diags.HasError() || configured.IsNull() || configured.IsUnknown() {
+		return headers, diags
+	}
+
+	if webhookHeadersEqualFold(configured.Elements(), headers.Elements()) {
+		return configured, diags
+	}
+	return headers, diags
+}
+
+// webhookHeadersEqualFold compares two header maps, ignoring the casing of the header names.
+func webhookHeadersEqualFold(a, b map[string]attr.Value) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	folded := make(map[string]attr.Value, len(b))
+	for name, value := range b {
+		folded[strings.ToLower(name)] = value
+	}
+	if len(folded) != len(a) {
+		return false
+	}
+
+	for name, value := range a {
+		other, ok := folded[strings.ToLower(name)]
+		if !ok || !value.Equal(other) {
+			return false
+		}
+	}
+	return true
+}
+
+var webhookURLRegexp = regexp.MustCompile(`^(https://)?[^/:\s]+(:[0-9]+)?(/[^\s]*)?$`)
+
 // mapToJSONFieldRequest converts a tf string map to a client.JSONFieldRequest.
 func mapToJSONFieldRequest(ctx context.Context, tfmap basetypes.MapValue) (client.JSONFieldRequest, diag.Diagnostics) {
 	if tfmap.IsNull() {
@@ -246,7 +387,7 @@ func toWebhookRequest(ctx context.Context, data *WebhookModel) (*client.WebhookR
 		Id:       data.ID.ValueStringPointer(),
 		Payload:  &payload,
 		Triggers: &triggers,
-		Url:      data.URL.ValueStringPointer(),
+		Url:      convert.ToPtr(normalizeWebhookURL(data.URL.ValueString())),
 	}, diags
 }
 
@@ -260,6 +401,12 @@ func (r *ResourceWebhook) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	appID := data.AppID.ValueString()
 
 	httpBody, diags := toWebhookRequest(ctx, data)
@@ -298,6 +445,12 @@ func (r *ResourceWebhook) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	appID := data.AppID.ValueString()
 	id := data.ID.ValueString()
 
@@ -332,6 +485,12 @@ func (r *ResourceWebhook) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	appID := data.AppID.ValueString()
 	id := data.ID.ValueString()
 
@@ -367,6 +526,12 @@ func (r *ResourceWebhook) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	appID := data.AppID.ValueString()
 	id := data.ID.ValueString()
 
@@ -383,14 +548,21 @@ func (r *ResourceWebhook) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *ResourceWebhook) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
+	idParts, err := importIDParts(req.ID, r.orgId, "apps", "webhooks")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Unable to parse import URL %q: %s", req.ID, err),
+		)
+		return
+	}
 
 	// ensure idParts elements are not empty
 	for _, idPart := range idParts {
 		if idPart == "" {
 			resp.Diagnostics.AddError(
 				"Unexpected Import Identifier",
-				fmt.Sprintf("Expected import identifier with format: app_id/webhook_id. Got: %q", req.ID),
+				fmt.Sprintf("Expected import identifier with format: app_id/webhook_id, app_id::webhook_id for ids containing slashes, or a Humanitec console URL. Got: %q", req.ID),
 			)
 			return
 		}
@@ -402,7 +574,7 @@ func (r *ResourceWebhook) ImportState(ctx context.Context, req resource.ImportSt
 	} else {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: app_id/webhook_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: app_id/webhook_id, app_id::webhook_id for ids containing slashes, or a Humanitec console URL. Got: %q", req.ID),
 		)
 		return
 	}
@@ -1,12 +1,20 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/convert"
 )
 
 func TestAccResourceWebhook(t *testing.T) {
@@ -33,7 +41,7 @@ func TestAccResourceWebhook(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			appId := fmt.Sprintf("tf-webhook-%d", time.Now().UnixNano())
 
-			resource.Test(t, resource.TestCase{
+			testAccTest(t, resource.TestCase{
 				PreCheck:                 func() { testAccPreCheck(t) },
 				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 				Steps: []resource.TestStep{
@@ -119,3 +127,102 @@ func testAccResourceWebhook_Full(id, url string) string {
 	}
 `, id, url)
 }
+
+func TestWebhookTriggerValidator(t *testing.T) {
+	tests := []struct {
+		scope string
+		type_ string
+		valid bool
+	}{
+		{scope: "environment", type_: "created", valid: true},
+		{scope: "environment", type_: "deleted", valid: true},
+		{scope: "deployment", type_: "started", valid: true},
+		{scope: "deployment", type_: "finished", valid: true},
+		{scope: "environment", type_: "started", valid: false},
+		{scope: "deployment", type_: "created", valid: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.scope+"/"+tc.type_, func(t *testing.T) {
+			resp := &validator.ObjectResponse{}
+			webhookTriggerValidator{}.ValidateObject(context.Background(), validator.ObjectRequest{
+				Path: path.Root("triggers"),
+				ConfigValue: types.ObjectValueMust(map[string]attr.Type{
+					"scope": types.StringType,
+					"type":  types.StringType,
+				}, map[string]attr.Value{
+					"scope": types.StringValue(tc.scope),
+					"type":  types.StringValue(tc.type_),
+				}),
+			}, resp)
+
+			assert.Equal(t, tc.valid, !resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestWebhookURLRegexp(t *testing.T) {
+	tests := []struct {
+		url   string
+		valid bool
+	}{
+		{url: "https://example.com/hook", valid: true},
+		{url: "example.com/hook", valid: true},
+		{url: "example.com:8443/hook", valid: true},
+		{url: "http://example.com/hook", valid: false},
+		{url: "ftp://example.com", valid: false},
+		{url: "example.com/hook with space", valid: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			assert.Equal(t, tc.valid, webhookURLRegexp.MatchString(tc.url))
+		})
+	}
+}
+
+func TestParseWebhookURL(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("example.com/hook", normalizeWebhookURL("https://example.com/hook"))
+	assert.Equal("example.com/hook", normalizeWebhookURL("example.com/hook"))
+
+	assert.Equal(types.StringValue("https://example.com/hook"), parseWebhookURL(convert.ToPtr("example.com/hook"), types.StringValue("https://example.com/hook")))
+	assert.Equal(types.StringValue("example.com/hook"), parseWebhookURL(convert.ToPtr("example.com/hook"), types.StringValue("example.com/hook")))
+	assert.Equal(types.StringValue("example.com/other"), parseWebhookURL(convert.ToPtr("example.com/other"), types.StringValue("https://example.com/hook")))
+	assert.Equal(types.StringValue("example.com/hook"), parseWebhookURL(convert.ToPtr("example.com/hook"), types.StringNull()))
+	assert.Equal(types.StringNull(), parseWebhookURL(nil, types.StringValue("https://example.com/hook")))
+}
+
+func TestParseWebhookHeaders(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	configured := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"X-Api-Key":    types.StringValue("secret"),
+		"content-type": types.StringValue("application/json"),
+	})
+
+	headers, diags := parseWebhookHeaders(ctx, map[string]interface{}{"x-api-key": "secret", "Content-Type": "application/json"}, configured)
+	assert.False(diags.HasError())
+	assert.Equal(configured, headers, "configured casing is preserved")
+
+	headers, diags = parseWebhookHeaders(ctx, map[string]interface{}{"x-api-key": "other", "Content-Type": "application/json"}, configured)
+	assert.False(diags.HasError())
+	assert.Equal(types.MapValueMust(types.StringType, map[string]attr.Value{
+		"x-api-key":    types.StringValue("other"),
+		"Content-Type": types.StringValue("application/json"),
+	}), headers, "changed values are reported")
+
+	headers, diags = parseWebhookHeaders(ctx, map[string]interface{}{"x-api-key": "secret"}, configured)
+	assert.False(diags.HasError())
+	assert.Equal(types.MapValueMust(types.StringType, map[string]attr.Value{
+		"x-api-key": types.StringValue("secret"),
+	}), headers, "removed headers are reported")
+
+	headers, diags = parseWebhookHeaders(ctx, map[string]interface{}{"x-api-key": "secret"}, types.MapNull(types.StringType))
+	assert.False(diags.HasError())
+	assert.Equal(types.MapValueMust(types.StringType, map[string]attr.Value{
+		"x-api-key": types.StringValue("secret"),
+	}), headers, "headers are read on import")
+}
@@ -3,7 +3,10 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -11,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -57,8 +61,11 @@ func (r *ResourceWorkloadProfile) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"spec_definition": schema.StringAttribute{
-				MarkdownDescription: "Workload spec definition",
+				MarkdownDescription: "Workload spec definition, a JSON encoded object validated against the structure of workload profile specs during plan. The configured value is kept as long as it's semantically equal to the one stored in Humanitec.",
 				Required:            true,
+				Validators: []validator.String{
+					workloadProfileSpecDefinitionValidator{},
+				},
 			},
 			"version": schema.StringAttribute{
 				Optional:            true,
@@ -84,19 +91,14 @@ func (r *ResourceWorkloadProfile) Schema(ctx context.Context, req resource.Schem
 }
 
 func (r *ResourceWorkloadProfile) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
 		return
 	}
 
-	resdata, ok := req.ProviderData.(*HumanitecData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
 		return
 	}
 
@@ -292,7 +294,10 @@ func parseWorkloadProfileResponse(cv *client.WorkloadProfileResponse, data *Work
 		diags.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to marshal spec definition, got error: %s", err))
 	}
 
-	data.SpecDefinition = types.StringValue(string(specDefinition))
+	// Keep the configured formatting as long as the stored spec definition is equivalent
+	if data.SpecDefinition.IsNull() || data.SpecDefinition.IsUnknown() || !workloadProfileSpecDefinitionEqual(data.SpecDefinition.ValueString(), string(specDefinition)) {
+		data.SpecDefinition = types.StringValue(string(specDefinition))
+	}
 	data.Version = types.StringValue(cv.Version)
 	data.WorkloadProfileChart = &WorkloadProfileChartReferenceModel{
 		ID:      types.StringValue(cv.WorkloadProfileChart.Id),
@@ -301,3 +306,113 @@ func parseWorkloadProfileResponse(cv *client.WorkloadProfileResponse, data *Work
 
 	return diags
 }
+
+// workloadProfileSpecDefinitionEqual reports whether the configured spec definition is equal to the one returned by the API,
+// after normalizing it the same way as when it's sent to the API.
+func workloadProfileSpecDefinitionEqual(configured, stored string) bool {
+	specDefinition, diags := toWorkloadProfileSpecDefinition(types.StringValue(configured))
+	if diags.HasError() {
+		return false
+	}
+
+	normalized, err := json.Marshal(specDefinition)
+	if err != nil {
+		return false
+	}
+
+	return jsonEqual(string(normalized), stored)
+}
+
+var workloadProfileFeatureNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*/[a-z0-9][a-z0-9_-]*$`)
+
+// checkWorkloadProfileSpecDefinition validates the properties and runtime_properties of a spec definition.
+func checkWorkloadProfileSpecDefinition(specDefinition string) error {
+	var spec map[string]interface{}
+	if err := json.Unmarshal([]byte(specDefinition), &spec); err != nil {
+		return fmt.Errorf("must be a JSON object: %w", err)
+	}
+
+	var errs []error
+	if properties, ok := spec["properties"]; ok {
+		errs = append(errs, checkWorkloadProfileSpecProperties("properties", properties)...)
+	}
+	if runtimeProperties, ok := spec["runtime_properties"]; ok {
+		list, ok := runtimeProperties.([]interface{})
+		if !ok {
+			errs = append(errs, errors.New("runtime_properties must be an array"))
+		}
+		for i, property := range list {
+			errs = append(errs, checkWorkloadProfileSpecProperty(fmt.Sprintf("runtime_properties[%d]", i), property)...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func checkWorkloadProfileSpecProperties(path string, value interface{}) []error {
+	properties, ok := value.(map[string]interface{})
+	if !ok {
+		return []error{fmt.Errorf("%s must be an object", path)}
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		errs = append(errs, checkWorkloadProfileSpecProperty(path+"."+name, properties[name])...)
+	}
+	return errs
+}
+
+func checkWorkloadProfileSpecProperty(path string, value interface{}) []error {
+	property, ok := value.(map[string]interface{})
+	if !ok {
+		return []error{fmt.Errorf("%s must be an object", path)}
+	}
+
+	var errs []error
+	featureName, hasFeatureName := property["feature_name"].(string)
+	if hasFeatureName && !workloadProfileFeatureNameRegexp.MatchString(featureName) {
+		errs = append(errs, fmt.Errorf("%s.feature_name must have the format namespace/name, e.g. humanitec/annotations, got: %q", path, featureName))
+	}
+
+	switch property["type"] {
+	case "feature":
+		if !hasFeatureName {
+			errs = append(errs, fmt.Errorf("%s.feature_name is required for features", path))
+		}
+	case "collection":
+		if properties, ok := property["properties"]; ok {
+			errs = append(errs, checkWorkloadProfileSpecProperties(path+".properties", properties)...)
+		}
+	default:
+		errs = append(errs, fmt.Errorf("%s.type must be feature or collection, got: %v", path, property["type"]))
+	}
+
+	return errs
+}
+
+// workloadProfileSpecDefinitionValidator ensures a string is a valid workload profile spec definition.
+type workloadProfileSpecDefinitionValidator struct{}
+
+func (v workloadProfileSpecDefinitionValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v workloadProfileSpecDefinitionValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a valid workload profile spec definition"
+}
+
+func (v workloadProfileSpecDefinitionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkWorkloadProfileSpecDefinition(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, HUM_INPUT_ERR, fmt.Sprintf("Invalid spec definition: %s", err))
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"mime/multipart"
+	"net/http"
 	"os"
 	"strings"
 
@@ -17,6 +18,8 @@ import (
 
 	"github.com/humanitec/humanitec-go-autogen"
 	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/pagination"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -69,19 +72,14 @@ func (r *ResourceWorkloadProfileChartVersion) Schema(ctx context.Context, req re
 }
 
 func (r *ResourceWorkloadProfileChartVersion) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
 		return
 	}
 
-	resdata, ok := req.ProviderData.(*HumanitecData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
 		return
 	}
 
@@ -158,20 +156,28 @@ func (r *ResourceWorkloadProfileChartVersion) Read(ctx context.Context, req reso
 	version := data.Version.ValueString()
 
 	var chartVersion *client.WorkloadProfileChartVersionResponse
-	listRes, err := r.client.ListWorkloadProfileChartVersionsWithResponse(ctx, r.orgID, &client.ListWorkloadProfileChartVersionsParams{
-		Id:      &id,
-		Version: &version,
+	chartVersions, err := pagination.All(ctx, func(ctx context.Context, editor pagination.RequestEditor) ([]client.WorkloadProfileChartVersionResponse, *http.Response, error) {
+		httpResp, err := r.client.ListWorkloadProfileChartVersionsWithResponse(ctx, r.orgID, &client.ListWorkloadProfileChartVersionsParams{
+			Id:      &id,
+			Version: &version,
+		}, editor)
+		if err != nil {
+			return nil, nil, err
+		}
+		if httpResp.StatusCode() != 200 {
+			return nil, nil, fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+		}
+		if httpResp.JSON200 == nil {
+			return nil, httpResp.HTTPResponse, nil
+		}
+		return *httpResp.JSON200, httpResp.HTTPResponse, nil
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list workload profile chart versions, got error: %s", err))
 		return
 	}
-	if listRes.StatusCode() != 200 {
-		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list workload profile chart versions, unexpected status code: %d, body: %s", listRes.StatusCode(), listRes.Body))
-		return
-	}
 
-	for _, v := range *listRes.JSON200 {
+	for _, v := range chartVersions {
 		if v.Id == id && v.Version == version {
 			chartVersion = &v
 			break
@@ -3,14 +3,21 @@ package provider
 import (
 	"archive/tar"
 	"compress/gzip"
+	"context"
 	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
@@ -41,7 +48,7 @@ version: %s
 
 	assert.NoError(t, compressDirectory(dir, f.Name()))
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -71,6 +78,58 @@ version: %s
 	})
 }
 
+func TestWorkloadProfileChartVersionReadPaginated(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	var requests []string
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("Link", fmt.Sprintf(`<%s%s?page=2>; rel="next"`, srv.URL, r.URL.Path))
+			fmt.Fprint(w, `[{"id": "other-chart", "version": "1.0.0", "org_id": "test-org", "created_at": "2024-01-01T00:00:00Z", "created_by": "test-user"}]`)
+			return
+		}
+		fmt.Fprint(w, `[{"id": "test-chart", "version": "1.0.0", "org_id": "test-org", "created_at": "2024-01-01T00:00:00Z", "created_by": "test-user"}]`)
+	}))
+	defer srv.Close()
+
+	humClient, err := NewHumanitecClient(srv.URL, "TEST_TOKEN", "test", &http.Client{})
+	assert.NoError(err)
+
+	chartVersion := &ResourceWorkloadProfileChartVersion{client: humClient, orgID: "test-org"}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	chartVersion.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	assert.False(schemaResp.Diagnostics.HasError())
+
+	model := &WorkloadProfileChartVersionModel{
+		ID:             types.StringValue("test-chart"),
+		Version:        types.StringValue("1.0.0"),
+		Filename:       types.StringValue("test-chart-1.0.0.tgz"),
+		SourceCodeHash: types.StringValue("hash"),
+	}
+
+	emptyValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: emptyValue}
+	assert.False(state.Set(ctx, model).HasError())
+
+	resp := &fwresource.ReadResponse{State: state}
+	chartVersion.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+	assert.False(resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	assert.Equal([]string{
+		"/orgs/test-org/workload-profile-chart-versions?id=test-chart&version=1.0.0",
+		"/orgs/test-org/workload-profile-chart-versions?id=test-chart&page=2&version=1.0.0",
+	}, requests)
+
+	var read *WorkloadProfileChartVersionModel
+	assert.False(resp.State.Get(ctx, &read).HasError())
+	assert.Equal(model, read)
+}
+
 func testAccResourceWorkloadProfileChartVersion(file string) string {
 	return fmt.Sprintf(`
 resource "humanitec_workload_profile_chart_version" "main" {
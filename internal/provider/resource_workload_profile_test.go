@@ -1,11 +1,14 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccResourceWorkloadProfile(t *testing.T) {
@@ -27,7 +30,7 @@ func TestAccResourceWorkloadProfile(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			workloadProfileID := fmt.Sprintf("profile-%d", time.Now().UnixNano())
 
-			resource.Test(t, resource.TestCase{
+			testAccTest(t, resource.TestCase{
 				PreCheck:                 func() { testAccPreCheck(t) },
 				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 				Steps: []resource.TestStep{
@@ -91,3 +94,44 @@ resource "humanitec_workload_profile" "main" {
 }
 `, id, description, version)
 }
+
+func TestCheckWorkloadProfileSpecDefinition(t *testing.T) {
+	tests := []struct {
+		name           string
+		specDefinition string
+		expectedErr    string
+	}{
+		{name: "empty", specDefinition: `{}`},
+		{
+			name:           "valid",
+			specDefinition: `{"properties": {"annotations": {"type": "feature", "feature_name": "humanitec/annotations"}, "containers": {"type": "collection", "feature_name": "humanitec/containers"}}, "runtime_properties": [{"type": "feature", "feature_name": "humanitec/ingress"}]}`,
+		},
+		{name: "not an object", specDefinition: `[]`, expectedErr: "must be a JSON object"},
+		{name: "missing type", specDefinition: `{"properties": {"annotations": {"feature_name": "humanitec/annotations"}}}`, expectedErr: "properties.annotations.type must be feature or collection, got: <nil>"},
+		{name: "missing feature name", specDefinition: `{"runtime_properties": [{"type": "feature"}]}`, expectedErr: "runtime_properties[0].feature_name is required for features"},
+		{name: "invalid feature name", specDefinition: `{"runtime_properties": [{"type": "feature", "feature_name": "annotations"}]}`, expectedErr: `runtime_properties[0].feature_name must have the format namespace/name, e.g. humanitec/annotations, got: "annotations"`},
+		{name: "invalid runtime properties", specDefinition: `{"runtime_properties": {}}`, expectedErr: "runtime_properties must be an array"},
+		{name: "nested collection", specDefinition: `{"properties": {"containers": {"type": "collection", "properties": {"main": {"type": "other"}}}}}`, expectedErr: "properties.containers.properties.main.type must be feature or collection, got: other"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkWorkloadProfileSpecDefinition(tc.specDefinition)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestWorkloadProfileSpecDefinitionEqual(t *testing.T) {
+	specDefinition, diags := toWorkloadProfileSpecDefinition(types.StringValue(`{}`))
+	assert.False(t, diags.HasError())
+	stored, err := json.Marshal(specDefinition)
+	assert.NoError(t, err)
+
+	assert.True(t, workloadProfileSpecDefinitionEqual("{\n  }", string(stored)), "formatting is ignored")
+	assert.False(t, workloadProfileSpecDefinitionEqual("invalid", string(stored)))
+}
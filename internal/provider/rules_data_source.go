@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/humanitec/humanitec-go-autogen"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/hashcode"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RulesDataSource{}
+
+func NewRulesDataSource() datasource.DataSource {
+	return &RulesDataSource{}
+}
+
+// RulesDataSource defines the data source implementation.
+type RulesDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// RulesDataSourceModel describes the data source data model.
+type RulesDataSourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	AppID types.String `tfsdk:"app_id"`
+	EnvID types.String `tfsdk:"env_id"`
+	Rules types.List   `tfsdk:"rules"`
+}
+
+// RuleDataSourceModel describes a single automation rule.
+type RuleDataSourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Active                 types.Bool   `tfsdk:"active"`
+	ArtefactsFilter        types.List   `tfsdk:"artefacts_filter"`
+	ExcludeArtefactsFilter types.Bool   `tfsdk:"exclude_artefacts_filter"`
+	MatchRef               types.String `tfsdk:"match_ref"`
+	Type                   types.String `tfsdk:"type"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+}
+
+var ruleAttrTypes = map[string]attr.Type{
+	"id":                       types.StringType,
+	"active":                   types.BoolType,
+	"artefacts_filter":         types.ListType{ElemType: types.StringType},
+	"exclude_artefacts_filter": types.BoolType,
+	"match_ref":                types.StringType,
+	"type":                     types.StringType,
+	"created_at":               types.StringType,
+}
+
+func (d *RulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rules"
+}
+
+func (d *RulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Automation Rules of an environment, e.g. to find stale rules by their creation time.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The Application ID.",
+				Required:            true,
+			},
+			"env_id": schema.StringAttribute{
+				MarkdownDescription: "The Environment ID.",
+				Required:            true,
+			},
+			"rules": schema.ListAttribute{
+				MarkdownDescription: "List of rules with their `id`, `active` flag, `artefacts_filter`, `exclude_artefacts_filter`, `match_ref`, `type` and `created_at` timestamp.",
+				ElementType: types.ObjectType{
+					AttrTypes: ruleAttrTypes,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *RulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *RulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RulesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := data.AppID.ValueString()
+	envID := data.EnvID.ValueString()
+
+	httpResp, err := d.client.ListAutomationRulesWithResponse(ctx, d.orgId, appID, envID)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list rules, got error: %s", err))
+		return
+	}
+
+	if httpResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list rules, unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	ruleIds := []string{}
+	rules := []basetypes.ObjectValue{}
+	if httpResp.JSON200 != nil {
+		for _, rule := range *httpResp.JSON200 {
+			artefactsFilter, diags := types.ListValueFrom(ctx, types.StringType, rule.ArtefactsFilter)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			item, diags := types.ObjectValueFrom(ctx, ruleAttrTypes, &RuleDataSourceModel{
+				ID:                     types.StringValue(rule.Id),
+				Active:                 types.BoolValue(rule.Active),
+				ArtefactsFilter:        artefactsFilter,
+				ExcludeArtefactsFilter: types.BoolValue(rule.ExcludeArtefactsFilter),
+				MatchRef:               types.StringValue(rule.MatchRef),
+				Type:                   types.StringValue(rule.Type),
+				CreatedAt:              types.StringValue(rule.CreatedAt.Format(time.RFC3339)),
+			})
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			ruleIds = append(ruleIds, rule.Id)
+			rules = append(rules, item)
+		}
+	}
+
+	ruleList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ruleAttrTypes}, rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Rules = ruleList
+	data.ID = types.StringValue(hashcode.Strings(append([]string{appID, envID}, ruleIds...)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccRulesDataSource(t *testing.T) {
+	appID := fmt.Sprintf("tf-rules-%d", time.Now().UnixNano())
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRulesDataSourceConfig(appID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.humanitec_rules.test", "rules.#", "1"),
+					resource.TestCheckResourceAttrPair("data.humanitec_rules.test", "rules.0.id", "humanitec_rule.rule1", "id"),
+					resource.TestCheckResourceAttrPair("data.humanitec_rules.test", "rules.0.created_at", "humanitec_rule.rule1", "created_at"),
+					resource.TestCheckResourceAttr("data.humanitec_rules.test", "rules.0.artefacts_filter.0", "my-artefact"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRulesDataSourceConfig(appID string) string {
+	return testAccResourceRule(appID, "my-artefact") + `
+	data "humanitec_rules" "test" {
+		app_id = humanitec_application.rule_test.id
+		env_id = "dev"
+
+		depends_on = [humanitec_rule.rule1]
+	}
+`
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// credentialAttributeNames are the names of attributes holding credentials, wherever they are nested.
+var credentialAttributeNames = map[string]bool{
+	"auth":              true,
+	"client_secret":     true,
+	"creds":             true,
+	"credentials":       true,
+	"password":          true,
+	"secret_access_key": true,
+	"secret_refs":       true,
+	"secrets":           true,
+	"secrets_string":    true,
+	"secrets_yaml":      true,
+	"sensitive_json":    true,
+	"token":             true,
+}
+
+// isCredentialAttribute reports whether the attribute at the dotted path holds credentials.
+func isCredentialAttribute(path string) bool {
+	name := path[strings.LastIndex(path, ".")+1:]
+	return credentialAttributeNames[name] || strings.HasSuffix(path, "secret_ref.value")
+}
+
+// TestCredentialAttributesSensitive ensures every attribute holding credentials is sensitive, so that they aren't shown in plan output.
+func TestCredentialAttributesSensitive(t *testing.T) {
+	server := providerserver.NewProtocol6(New("test")())()
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Failed to get provider schema: %v", err)
+	}
+
+	check := func(kind string, schemas map[string]*tfprotov6.Schema) {
+		for typeName, schema := range schemas {
+			for path, attr := range newSchemaSnapshot(schema).Attributes {
+				if isCredentialAttribute(path) && !attr.Sensitive {
+					t.Errorf("%s %s: %s holds credentials and must be sensitive", kind, typeName, path)
+				}
+			}
+		}
+	}
+
+	check("provider", map[string]*tfprotov6.Schema{"humanitec": resp.Provider})
+	check("resource", resp.ResourceSchemas)
+	check("data source", resp.DataSourceSchemas)
+}
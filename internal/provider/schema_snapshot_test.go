@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+)
+
+var updateSchemaSnapshots = flag.Bool("update-schema-snapshots", false, "rewrite the schema snapshots in testdata/schemas")
+
+const schemaSnapshotsDir = "testdata/schemas"
+
+// schemaSnapshot is the serialized form of a resource or data source schema,
+// attributes are keyed by their dotted path to keep the golden files diffable.
+type schemaSnapshot struct {
+	Version    int64                        `json:"version"`
+	Attributes map[string]attributeSnapshot `json:"attributes"`
+}
+
+type attributeSnapshot struct {
+	Type      string `json:"type"`
+	Required  bool   `json:"required,omitempty"`
+	Optional  bool   `json:"optional,omitempty"`
+	Computed  bool   `json:"computed,omitempty"`
+	Sensitive bool   `json:"sensitive,omitempty"`
+}
+
+func newSchemaSnapshot(schema *tfprotov6.Schema) schemaSnapshot {
+	snapshot := schemaSnapshot{
+		Version:    schema.Version,
+		Attributes: map[string]attributeSnapshot{},
+	}
+	if schema.Block != nil {
+		snapshotBlock(snapshot.Attributes, "", schema.Block)
+	}
+	return snapshot
+}
+
+func snapshotBlock(attributes map[string]attributeSnapshot, prefix string, block *tfprotov6.SchemaBlock) {
+	snapshotAttributes(attributes, prefix, block.Attributes)
+	for _, nested := range block.BlockTypes {
+		path := prefix + nested.TypeName
+		attributes[path] = attributeSnapshot{
+			Type: "block:" + blockNestingMode(nested.Nesting),
+		}
+		if nested.Block != nil {
+			snapshotBlock(attributes, path+".", nested.Block)
+		}
+	}
+}
+
+func snapshotAttributes(attributes map[string]attributeSnapshot, prefix string, schemaAttributes []*tfprotov6.SchemaAttribute) {
+	for _, attr := range schemaAttributes {
+		path := prefix + attr.Name
+		snapshot := attributeSnapshot{
+			Required:  attr.Required,
+			Optional:  attr.Optional,
+			Computed:  attr.Computed,
+			Sensitive: attr.Sensitive,
+		}
+		if attr.NestedType != nil {
+			snapshot.Type = "nested:" + objectNestingMode(attr.NestedType.Nesting)
+			snapshotAttributes(attributes, path+".", attr.NestedType.Attributes)
+		} else if attr.Type != nil {
+			snapshot.Type = attr.Type.String()
+		}
+		attributes[path] = snapshot
+	}
+}
+
+func blockNestingMode(mode tfprotov6.SchemaNestedBlockNestingMode) string {
+	switch mode {
+	case tfprotov6.SchemaNestedBlockNestingModeSingle:
+		return "single"
+	case tfprotov6.SchemaNestedBlockNestingModeList:
+		return "list"
+	case tfprotov6.SchemaNestedBlockNestingModeSet:
+		return "set"
+	case tfprotov6.SchemaNestedBlockNestingModeMap:
+		return "map"
+	case tfprotov6.SchemaNestedBlockNestingModeGroup:
+		return "group"
+	default:
+		return fmt.Sprintf("unknown(%d)", mode)
+	}
+}
+
+func objectNestingMode(mode tfprotov6.SchemaObjectNestingMode) string {
+	switch mode {
+	case tfprotov6.SchemaObjectNestingModeSingle:
+		return "single"
+	case tfprotov6.SchemaObjectNestingModeList:
+		return "list"
+	case tfprotov6.SchemaObjectNestingModeSet:
+		return "set"
+	case tfprotov6.SchemaObjectNestingModeMap:
+		return "map"
+	default:
+		return fmt.Sprintf("unknown(%d)", mode)
+	}
+}
+
+// breakingSchemaChanges lists the changes between two snapshots that break
+// existing configurations or state.
+func breakingSchemaChanges(previous, current schemaSnapshot) []string {
+	var changes []string
+	if current.Version < previous.Version {
+		changes = append(changes, fmt.Sprintf("schema version decreased from %d to %d", previous.Version, current.Version))
+	}
+	for path, before := range previous.Attributes {
+		after, ok := current.Attributes[path]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("%s: removed", path))
+			continue
+		}
+		if before.Type != after.Type {
+			changes = append(changes, fmt.Sprintf("%s: type changed from %s to %s", path, before.Type, after.Type))
+		}
+		if !before.Required && after.Required {
+			changes = append(changes, fmt.Sprintf("%s: became required", path))
+		}
+		if (before.Required || before.Optional) && !after.Required && !after.Optional {
+			changes = append(changes, fmt.Sprintf("%s: can no longer be configured", path))
+		}
+	}
+	for path, after := range current.Attributes {
+		if _, ok := previous.Attributes[path]; !ok && after.Required {
+			changes = append(changes, fmt.Sprintf("%s: added as required", path))
+		}
+	}
+	sort.Strings(changes)
+	return changes
+}
+
+func TestBreakingSchemaChanges(t *testing.T) {
+	previous := schemaSnapshot{
+		Version: 1,
+		Attributes: map[string]attributeSnapshot{
+			"id":       {Type: "tftypes.String", Computed: true},
+			"name":     {Type: "tftypes.String", Optional: true},
+			"replicas": {Type: "tftypes.Number", Optional: true},
+			"removed":  {Type: "tftypes.Bool", Optional: true},
+		},
+	}
+
+	assert.Empty(t, breakingSchemaChanges(previous, previous))
+
+	current := schemaSnapshot{
+		Version: 0,
+		Attributes: map[string]attributeSnapshot{
+			"id":       {Type: "tftypes.String", Computed: true},
+			"name":     {Type: "tftypes.String", Required: true},
+			"replicas": {Type: "tftypes.String", Computed: true},
+			"added":    {Type: "tftypes.String", Required: true},
+			"optional": {Type: "tftypes.String", Optional: true},
+		},
+	}
+
+	assert.Equal(t, []string{
+		"added: added as required",
+		"name: became required",
+		"removed: removed",
+		"replicas: can no longer be configured",
+		"replicas: type changed from tftypes.Number to tftypes.String",
+		"schema version decreased from 1 to 0",
+	}, breakingSchemaChanges(previous, current))
+}
+
+// TestSchemaSnapshots compares every resource and data source schema with the
+// golden files in testdata/schemas. Run it with -update-schema-snapshots after
+// an intentional schema change to refresh them.
+func TestSchemaSnapshots(t *testing.T) {
+	server := providerserver.NewProtocol6(New("test")())()
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Failed to get provider schema: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("Failed to get provider schema: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	checkSchemaSnapshots(t, "resources", resp.ResourceSchemas)
+	checkSchemaSnapshots(t, "data-sources", resp.DataSourceSchemas)
+}
+
+func checkSchemaSnapshots(t *testing.T, kind string, schemas map[string]*tfprotov6.Schema) {
+	dir := filepath.Join(schemaSnapshotsDir, kind)
+	if *updateSchemaSnapshots {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatalf("Failed to clean %s: %v", dir, err)
+		}
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("Failed to create %s: %v", dir, err)
+	}
+
+	for typeName, schema := range schemas {
+		t.Run(kind+"/"+typeName, func(t *testing.T) {
+			current := newSchemaSnapshot(schema)
+			path := filepath.Join(dir, typeName+".json")
+
+			if *updateSchemaSnapshots {
+				writeSchemaSnapshot(t, path, current)
+				return
+			}
+
+			content, err := os.ReadFile(path)
+			if errors.Is(err, os.ErrNotExist) {
+				t.Fatalf("No schema snapshot for %s, rerun with -update-schema-snapshots to create %s", typeName, path)
+			}
+			if err != nil {
+				t.Fatalf("Failed to read %s: %v", path, err)
+			}
+
+			var previous schemaSnapshot
+			if err := json.Unmarshal(content, &previous); err != nil {
+				t.Fatalf("Failed to parse %s: %v", path, err)
+			}
+
+			if changes := breakingSchemaChanges(previous, current); len(changes) > 0 {
+				t.Errorf("Breaking schema changes in %s, bump the schema version with a state upgrader or rerun with -update-schema-snapshots if intended:\n%s", typeName, strings.Join(changes, "\n"))
+				return
+			}
+			assert.Equal(t, previous, current, "Schema of %s changed, rerun with -update-schema-snapshots to update %s", typeName, path)
+		})
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Failed to list %s: %v", dir, err)
+	}
+	for _, entry := range entries {
+		typeName := strings.TrimSuffix(entry.Name(), ".json")
+		if _, ok := schemas[typeName]; !ok {
+			t.Errorf("%s %s was removed, rerun with -update-schema-snapshots if intended", kind, typeName)
+		}
+	}
+}
+
+func writeSchemaSnapshot(t *testing.T, path string, snapshot schemaSnapshot) {
+	content, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		t.Fatalf("Failed to serialize %s: %v", path, err)
+	}
+	if err := os.WriteFile(path, append(content, '\n'), 0644); err != nil {
+		t.Fatalf("Failed to write %s: %v", path, err)
+	}
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/hashcode"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SecretStoresDataSource{}
+
+func NewSecretStoresDataSource() datasource.DataSource {
+	return &SecretStoresDataSource{}
+}
+
+// SecretStoresDataSource defines the data source implementation.
+type SecretStoresDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// SecretStoresDataSourceModel describes the data source data model.
+type SecretStoresDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	SecretStores types.List   `tfsdk:"secretstores"`
+}
+
+// SecretStoreDataSourceModel describes a single secret store without any credentials.
+type SecretStoreDataSourceModel struct {
+	ID      types.String            `tfsdk:"id"`
+	Primary types.Bool              `tfsdk:"primary"`
+	Type    types.String            `tfsdk:"type"`
+	AwsSM   *AwsSMDataSourceModel   `tfsdk:"awssm"`
+	AzureKV *AzureKVDataSourceModel `tfsdk:"azurekv"`
+	GcpSM   *GcpSMDataSourceModel   `tfsdk:"gcpsm"`
+	Vault   *VaultDataSourceModel   `tfsdk:"vault"`
+}
+
+type AwsSMDataSourceModel struct {
+	Region types.String `tfsdk:"region"`
+}
+
+type AzureKVDataSourceModel struct {
+	TenantID types.String `tfsdk:"tenant_id"`
+	Url      types.String `tfsdk:"url"`
+}
+
+type GcpSMDataSourceModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+}
+
+type VaultDataSourceModel struct {
+	AgentID types.String `tfsdk:"agent_id"`
+	Path    types.String `tfsdk:"path"`
+	Url     types.String `tfsdk:"url"`
+}
+
+var secretStoreAttrTypes = map[string]attr.Type{
+	"id":      types.StringType,
+	"primary": types.BoolType,
+	"type":    types.StringType,
+	"awssm": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"region": types.StringType,
+	}},
+	"azurekv": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"tenant_id": types.StringType,
+		"url":       types.StringType,
+	}},
+	"gcpsm": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"project_id": types.StringType,
+	}},
+	"vault": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"agent_id": types.StringType,
+		"path":     types.StringType,
+		"url":      types.StringType,
+	}},
+}
+
+func (d *SecretStoresDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secretstores"
+}
+
+func (d *SecretStoresDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Humanitec secret stores of the organization. Credentials are never exposed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"secretstores": schema.ListAttribute{
+				MarkdownDescription: "List of secret stores with their `id`, `primary` flag, backend `type` (one of `awssm`, `azurekv`, `gcpsm`, `vault` or `humanitec`) and non-sensitive backend configuration.",
+				ElementType: types.ObjectType{
+					AttrTypes: secretStoreAttrTypes,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *SecretStoresDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *SecretStoresDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SecretStoresDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := d.client.GetOrgsOrgIdSecretstoresWithResponse(ctx, d.orgId)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list secret stores, got error: %s", err))
+		return
+	}
+	if httpResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list secret stores, unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	storeIds := []string{}
+	stores := []basetypes.ObjectValue{}
+	if httpResp.JSON200 != nil {
+		for _, res := range *httpResp.JSON200 {
+			store, diags := types.ObjectValueFrom(ctx, secretStoreAttrTypes, parseSecretStoreDataSourceResponse(res))
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			storeIds = append(storeIds, res.Id)
+			stores = append(stores, store)
+		}
+	}
+
+	storesList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: secretStoreAttrTypes}, stores)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SecretStores = storesList
+	data.ID = types.StringValue(hashcode.Strings(storeIds))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func parseSecretStoreDataSourceResponse(res client.SecretStoreResponse) *SecretStoreDataSourceModel {
+	store := &SecretStoreDataSourceModel{
+		ID:      types.StringValue(res.Id),
+		Primary: types.BoolValue(res.Primary),
+		Type:    types.StringNull(),
+	}
+
+	switch {
+	case res.Awssm != nil:
+		store.Type = types.StringValue("awssm")
+		store.AwsSM = &AwsSMDataSourceModel{
+			Region: types.StringPointerValue(res.Awssm.Region),
+		}
+	case res.Azurekv != nil:
+		store.Type = types.StringValue("azurekv")
+		store.AzureKV = &AzureKVDataSourceModel{
+			TenantID: types.StringPointerValue(res.Azurekv.TenantId),
+			Url:      types.StringPointerValue(res.Azurekv.Url),
+		}
+	case res.Gcpsm != nil:
+		store.Type = types.StringValue("gcpsm")
+		store.GcpSM = &GcpSMDataSourceModel{
+			ProjectID: types.StringPointerValue(res.Gcpsm.ProjectId),
+		}
+	case res.Vault != nil:
+		store.Type = types.StringValue("vault")
+		store.Vault = &VaultDataSourceModel{
+			AgentID: types.StringPointerValue(res.Vault.AgentId),
+			Path:    types.StringPointerValue(res.Vault.Path),
+			Url:     types.StringPointerValue(res.Vault.Url),
+		}
+	case res.Humanitec != nil:
+		store.Type = types.StringValue("humanitec")
+	}
+
+	return store
+}
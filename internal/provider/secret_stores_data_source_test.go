@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSecretStoresDataSource(t *testing.T) {
+	id := fmt.Sprintf("gcpsm-test-%d", time.Now().UnixNano())
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSecretStoresDataSourceConfig(id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.humanitec_secretstores.test", "secretstores.0.id"),
+					resource.TestCheckOutput("store_type", "gcpsm"),
+					resource.TestCheckOutput("store_project_id", "test-project-id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecretStoresDataSourceConfig(storeID string) string {
+	return fmt.Sprintf(`
+resource "humanitec_secretstore" "test" {
+	id = "%s"
+	gcpsm = {
+		project_id = "test-project-id"
+		auth = {
+			secret_access_key = "secret-access-key"
+		}
+	}
+}
+
+data "humanitec_secretstores" "test" {
+	depends_on = [humanitec_secretstore.test]
+}
+
+locals {
+	store = one([for s in data.humanitec_secretstores.test.secretstores : s if s.id == humanitec_secretstore.test.id])
+}
+
+output "store_type" {
+	value = local.store.type
+}
+
+output "store_project_id" {
+	value = local.store.gcpsm.project_id
+}
+`, storeID)
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserDataSource{}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+// UserDataSource defines the data source implementation.
+type UserDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// UserDataSourceModel describes the data source data model.
+type UserDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Email     types.String `tfsdk:"email"`
+	Name      types.String `tfsdk:"name"`
+	Role      types.String `tfsdk:"role"`
+	Type      types.String `tfsdk:"type"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A single user of the organization, looked up by `email` or, e.g. for service users which have no email, by `name`. Fails unless exactly one user matches.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user.",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the user.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("email"), path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the user.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The role of the user in the organization.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the user, e.g. `user` or `service`.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp of when the user was created.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := d.client.ListUserRolesInOrgWithResponse(ctx, d.orgId)
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list users, got error: %s", err))
+		return
+	}
+	if httpResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list users, unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	var userRoles []client.UserRoleResponse
+	if httpResp.JSON200 != nil {
+		userRoles = *httpResp.JSON200
+	}
+
+	userRole, err := findUserRole(userRoles, UsersFilterDataSourceModel{
+		Email: data.Email,
+		Name:  data.Name,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_INPUT_ERR, err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(userRole.Id)
+	data.Email = types.StringPointerValue(userRole.Email)
+	data.Name = types.StringValue(userRole.Name)
+	data.Role = types.StringValue(userRole.Role)
+	data.Type = types.StringValue(userRole.Type)
+	data.CreatedAt = types.StringValue(userRole.CreatedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findUserRole returns the only user matching the filter.
+func findUserRole(userRoles []client.UserRoleResponse, filter UsersFilterDataSourceModel) (*client.UserRoleResponse, error) {
+	var found *client.UserRoleResponse
+	for i := range userRoles {
+		if !userRoleMatches(userRoles[i], filter) {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("multiple users match %s, use humanitec_users to list them", describeUserFilter(filter))
+		}
+		found = &userRoles[i]
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("no user matches %s", describeUserFilter(filter))
+	}
+
+	return found, nil
+}
+
+func describeUserFilter(filter UsersFilterDataSourceModel) string {
+	if !filter.Email.IsNull() {
+		return fmt.Sprintf("email %q", filter.Email.ValueString())
+	}
+	return fmt.Sprintf("name %q", filter.Name.ValueString())
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/humanitec/humanitec-go-autogen/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccUserDataSource(t *testing.T) {
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "humanitec_users" "all" {}
+
+data "humanitec_user" "test" {
+	name = data.humanitec_users.all.users[0].name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.humanitec_user.test", "id", "data.humanitec_users.all", "users.0.id"),
+					resource.TestCheckResourceAttrPair("data.humanitec_user.test", "role", "data.humanitec_users.all", "users.0.role"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindUserRole(t *testing.T) {
+	assert := assert.New(t)
+
+	email := "jane@example.com"
+	userRoles := []client.UserRoleResponse{
+		{Id: "user-a", Name: "Jane", Email: &email, Type: "user"},
+		{Id: "user-b", Name: "ci-bot", Type: "service"},
+		{Id: "user-c", Name: "ci-bot", Type: "service"},
+		{Id: "user-d", Name: "deployer", Type: "service"},
+	}
+
+	userRole, err := findUserRole(userRoles, UsersFilterDataSourceModel{Email: types.StringValue(email)})
+	assert.NoError(err)
+	assert.Equal("user-a", userRole.Id)
+
+	userRole, err = findUserRole(userRoles, UsersFilterDataSourceModel{Name: types.StringValue("deployer")})
+	assert.NoError(err)
+	assert.Equal("user-d", userRole.Id)
+
+	_, err = findUserRole(userRoles, UsersFilterDataSourceModel{Name: types.StringValue("ci-bot")})
+	assert.EqualError(err, `multiple users match name "ci-bot", use humanitec_users to list them`)
+
+	_, err = findUserRole(userRoles, UsersFilterDataSourceModel{Email: types.StringValue("john@example.com")})
+	assert.EqualError(err, `no user matches email "john@example.com"`)
+}
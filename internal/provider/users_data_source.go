@@ -39,6 +39,7 @@ type UsersFilterDataSourceModel struct {
 	Id    types.String `tfsdk:"id"`
 	Name  types.String `tfsdk:"name"`
 	Email types.String `tfsdk:"email"`
+	Type  types.String `tfsdk:"type"`
 }
 
 var userAttrTypes = map[string]attr.Type{
@@ -73,6 +74,10 @@ func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 					"email": schema.StringAttribute{
 						Optional: true,
 					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the users, e.g. `user` or `service`.",
+						Optional:            true,
+					},
 				},
 				Optional: true,
 			},
@@ -87,19 +92,14 @@ func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 }
 
 func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
 		return
 	}
 
-	resdata, ok := req.ProviderData.(*HumanitecData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
 		return
 	}
 
@@ -167,22 +167,28 @@ func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 }
 
 func matchesFilters(ctx context.Context, filter basetypes.ObjectValue, userRole client.UserRoleResponse) (bool, diag.Diagnostics) {
-	var id, name, email *string
+	var parsedFilter UsersFilterDataSourceModel
 	if !filter.IsNull() {
-		var parsedFilter UsersFilterDataSourceModel
 		diags := filter.As(ctx, &parsedFilter, basetypes.ObjectAsOptions{})
 		if len(diags) != 0 {
 			return false, diags
 		}
-
-		id = parsedFilter.Id.ValueStringPointer()
-		name = parsedFilter.Name.ValueStringPointer()
-		email = parsedFilter.Email.ValueStringPointer()
 	}
 
+	return userRoleMatches(userRole, parsedFilter), diag.Diagnostics{}
+}
+
+// userRoleMatches reports whether the user matches all attributes set in the filter.
+func userRoleMatches(userRole client.UserRoleResponse, filter UsersFilterDataSourceModel) bool {
+	id := filter.Id.ValueStringPointer()
+	name := filter.Name.ValueStringPointer()
+	email := filter.Email.ValueStringPointer()
+	userType := filter.Type.ValueStringPointer()
+
 	matchesIdIfSet := id == nil || userRole.Id == *id
 	matchesNameIfSet := name == nil || userRole.Name == *name
 	matchesEmailIfSet := email == nil || (userRole.Email != nil && *userRole.Email == *email)
+	matchesTypeIfSet := userType == nil || userRole.Type == *userType
 
-	return matchesIdIfSet && matchesNameIfSet && matchesEmailIfSet, diag.Diagnostics{}
+	return matchesIdIfSet && matchesNameIfSet && matchesEmailIfSet && matchesTypeIfSet
 }
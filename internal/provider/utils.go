@@ -2,12 +2,22 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"maps"
+	"math/big"
+	"net/url"
 	"os"
+	"reflect"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"sigs.k8s.io/yaml"
 )
 
@@ -17,6 +27,85 @@ type Config struct {
 	Token     string `yaml:"token,omitempty"`
 }
 
+// defaultTimeout is used for resource operations without a configured timeout.
+const defaultTimeout = 10 * time.Minute
+
+// withTimeout returns a context that is cancelled after the configured timeout of an operation, e.g.
+// data.Timeouts.Create, or after defaultTimeout if none is configured.
+func withTimeout(ctx context.Context, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	d, timeoutDiags := timeout(ctx, defaultTimeout)
+	diags.Append(timeoutDiags...)
+	if timeoutDiags.HasError() {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, d)
+}
+
+// consoleURLCollections are the path segments of a Humanitec console URL that are followed by an id.
+var consoleURLCollections = map[string]bool{
+	"orgs":      true,
+	"apps":      true,
+	"envs":      true,
+	"values":    true,
+	"webhooks":  true,
+	"pipelines": true,
+}
+
+// importIDParts splits an import identifier into its parts, see splitImportID. Humanitec console URLs like
+// https://app.humanitec.io/orgs/x/apps/y/envs/z are split into the ids of the given collections instead, collections
+// missing from the URL are skipped.
+func importIDParts(id, orgID string, collections ...string) ([]string, error) {
+	if !strings.HasPrefix(id, "https://") && !strings.HasPrefix(id, "http://") {
+		return splitImportID(id), nil
+	}
+
+	u, err := url.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+
+	// Split the escaped path, so that ids containing encoded slashes, like value keys, stay in one piece
+	ids := map[string]string{}
+	segments := strings.Split(strings.Trim(u.EscapedPath(), "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if consoleURLCollections[segments[i]] {
+			segment, err := url.PathUnescape(segments[i+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid URL: %w", err)
+			}
+			ids[segments[i]] = segment
+			i++
+		}
+	}
+
+	if org, ok := ids["orgs"]; !ok {
+		return nil, errors.New("the URL doesn't contain an organization")
+	} else if orgID != "" && org != orgID {
+		return nil, fmt.Errorf("the URL belongs to organization %q, but the provider is configured for %q", org, orgID)
+	}
+
+	parts := make([]string, 0, len(collections))
+	for _, collection := range collections {
+		if id, ok := ids[collection]; ok {
+			parts = append(parts, id)
+		}
+	}
+	return parts, nil
+}
+
+// importIDSeparator separates the parts of import identifiers containing ids with slashes, e.g. app_id::env_id::key.
+const importIDSeparator = "::"
+
+// splitImportID splits an import identifier into its parts, they are separated by importIDSeparator if it's used and by
+// slashes otherwise.
+func splitImportID(id string) []string {
+	if strings.Contains(id, importIDSeparator) {
+		return strings.Split(id, importIDSeparator)
+	}
+	return strings.Split(id, "/")
+}
+
 func valueAtPath[T any](input map[string]interface{}, path []string) (T, bool) {
 	lenPath := len(path)
 
@@ -39,29 +128,13 @@ func valueAtPath[T any](input map[string]interface{}, path []string) (T, bool) {
 	return v, ok
 }
 
-func findInSlicePtr[T any](in *[]T, f func(T) bool) (T, bool) {
-	found := false
-	var element T
-
-	if in == nil {
-		return element, found
-	}
-
-	for _, e := range *in {
-		if f(e) {
-			found = true
-			element = e
-			break
-		}
-	}
-
-	return element, found
-}
-
 func readConfig(data HumanitecProviderModel) (config Config, diags diag.Diagnostics) {
 	diags = diag.Diagnostics{}
 	// Check for .humctl file generated by humctl command line tool
 	configFilePath := data.Config.ValueString()
+	if configFilePath == "" {
+		configFilePath = os.Getenv("HUMCTL_CONFIG")
+	}
 	if configFilePath == "" {
 		homeDir, err := os.UserHomeDir()
 		if err != nil {
@@ -81,7 +154,7 @@ func readConfig(data HumanitecProviderModel) (config Config, diags diag.Diagnost
 		diags.AddError(
 			"Unable to read config file",
 			"Terraform was unable to read config file mentioned "+
-				"in the config attribute.",
+				"in the config attribute or HUMCTL_CONFIG environment variable.",
 		)
 		return
 	}
@@ -112,10 +185,6 @@ func readConfig(data HumanitecProviderModel) (config Config, diags diag.Diagnost
 	return
 }
 
-func toPtr[T any](value T) *T {
-	return &value
-}
-
 // strictUnmarshal unmarshals the JSON data into the provided value and returns an error if the data contains unknown fields.
 func strictUnmarshal(data []byte, v interface{}) error {
 	dec := json.NewDecoder(bytes.NewReader(data))
@@ -131,3 +200,190 @@ func overrideMap[M ~map[K]V, K comparable, V any](base M, override M) {
 	})
 	maps.Copy(base, override)
 }
+
+// unmarshalJSONOrYAML unmarshals the JSON or YAML encoded data into the provided value.
+func unmarshalJSONOrYAML(data string, v interface{}) error {
+	return yaml.Unmarshal([]byte(data), v)
+}
+
+// semanticallyEqual reports whether the JSON or YAML encoded data decodes to the same structure as value.
+func semanticallyEqual(data string, value interface{}) bool {
+	var decoded interface{}
+	if err := unmarshalJSONOrYAML(data, &decoded); err != nil {
+		return false
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+
+	var normalized interface{}
+	if err := json.Unmarshal(b, &normalized); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(decoded, normalized)
+}
+
+// normalizeJSON returns the compact JSON encoding of data with sorted object keys.
+func normalizeJSON(data string) (string, error) {
+	dec := json.NewDecoder(strings.NewReader(data))
+	dec.UseNumber()
+
+	var decoded interface{}
+	if err := dec.Decode(&decoded); err != nil {
+		return "", err
+	}
+	if dec.More() {
+		return "", errors.New("unexpected data after the JSON value")
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(decoded); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// jsonEqual reports whether both strings are valid JSON with the same normalized encoding.
+func jsonEqual(a, b string) bool {
+	normalizedA, err := normalizeJSON(a)
+	if err != nil {
+		return false
+	}
+	normalizedB, err := normalizeJSON(b)
+	if err != nil {
+		return false
+	}
+	return normalizedA == normalizedB
+}
+
+// yamlEqual reports whether both strings are valid YAML documents with the same content, ignoring formatting and key order.
+func yamlEqual(a, b string) bool {
+	jsonA, err := yaml.YAMLToJSON([]byte(a))
+	if err != nil {
+		return false
+	}
+	jsonB, err := yaml.YAMLToJSON([]byte(b))
+	if err != nil {
+		return false
+	}
+	return jsonEqual(string(jsonA), string(jsonB))
+}
+
+// attrValueToInterface converts a Terraform value, e.g. the content of a dynamic attribute, into its JSON compatible Go representation.
+func attrValueToInterface(value attr.Value) (interface{}, error) {
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, errors.New("value is unknown")
+	}
+
+	switch v := value.(type) {
+	case basetypes.DynamicValue:
+		return attrValueToInterface(v.UnderlyingValue())
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+	case basetypes.NumberValue:
+		f, _ := v.ValueBigFloat().Float64()
+		return f, nil
+	case basetypes.Int64Value:
+		return float64(v.ValueInt64()), nil
+	case basetypes.Float64Value:
+		return v.ValueFloat64(), nil
+	case basetypes.ListValue:
+		return attrValuesToInterface(v.Elements())
+	case basetypes.SetValue:
+		return attrValuesToInterface(v.Elements())
+	case basetypes.TupleValue:
+		return attrValuesToInterface(v.Elements())
+	case basetypes.MapValue:
+		return attrValueMapToInterface(v.Elements())
+	case basetypes.ObjectValue:
+		return attrValueMapToInterface(v.Attributes())
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+func attrValuesToInterface(elements []attr.Value) (interface{}, error) {
+	res := make([]interface{}, 0, len(elements))
+	for _, elem := range elements {
+		v, err := attrValueToInterface(elem)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+func attrValueMapToInterface(elements map[string]attr.Value) (interface{}, error) {
+	res := make(map[string]interface{}, len(elements))
+	for k, elem := range elements {
+		v, err := attrValueToInterface(elem)
+		if err != nil {
+			return nil, err
+		}
+		res[k] = v
+	}
+	return res, nil
+}
+
+// interfaceToAttrValue converts a decoded JSON value into a Terraform value suitable for a dynamic attribute.
+// Objects become object values, arrays tuple values and null a null string.
+func interfaceToAttrValue(value interface{}) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch v := value.(type) {
+	case nil:
+		return types.StringNull(), diags
+	case string:
+		return types.StringValue(v), diags
+	case bool:
+		return types.BoolValue(v), diags
+	case float64:
+		return types.NumberValue(big.NewFloat(v)), diags
+	case []interface{}:
+		elemTypes := make([]attr.Type, 0, len(v))
+		elems := make([]attr.Value, 0, len(v))
+		for _, item := range v {
+			elem, d := interfaceToAttrValue(item)
+			diags.Append(d...)
+			elemTypes = append(elemTypes, elem.Type(context.Background()))
+			elems = append(elems, elem)
+		}
+		if diags.HasError() {
+			return nil, diags
+		}
+		tuple, d := types.TupleValue(elemTypes, elems)
+		diags.Append(d...)
+		return tuple, diags
+	case map[string]interface{}:
+		attrTypes := make(map[string]attr.Type, len(v))
+		attrs := make(map[string]attr.Value, len(v))
+		for k, item := range v {
+			elem, d := interfaceToAttrValue(item)
+			diags.Append(d...)
+			if elem != nil {
+				attrTypes[k] = elem.Type(context.Background())
+				attrs[k] = elem
+			}
+		}
+		if diags.HasError() {
+			return nil, diags
+		}
+		obj, d := types.ObjectValue(attrTypes, attrs)
+		diags.Append(d...)
+		return obj, diags
+	default:
+		diags.AddError(HUM_API_ERR, fmt.Sprintf("Unsupported value type %T", value))
+		return nil, diags
+	}
+}
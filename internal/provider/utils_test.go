@@ -1,9 +1,14 @@
 package provider
 
 import (
+	"context"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 	"sigs.k8s.io/yaml"
@@ -59,6 +64,32 @@ func TestReadConfig(t *testing.T) {
 	assert.Equal(config, configData)
 }
 
+func TestReadConfigFromEnv(t *testing.T) {
+	assert := assert.New(t)
+	configData := Config{
+		Org:   "unittest-env-org",
+		Token: "unittest-env-token",
+	}
+
+	configBytes, err := yaml.Marshal(configData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	configPath := t.TempDir() + "/config.yaml"
+	if err := os.WriteFile(configPath, configBytes, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("HUMCTL_CONFIG", configPath)
+
+	config, diags := readConfig(HumanitecProviderModel{
+		Config: types.StringNull(),
+	})
+	assert.Len(diags, 0)
+	assert.Equal(configData, config)
+}
+
 func TestReadConfigNonExistentFile(t *testing.T) {
 	assert := assert.New(t)
 	currentDirectory, err := os.Getwd()
@@ -126,3 +157,200 @@ func TestOverrideMap(t *testing.T) {
 		"new key": "new value 2",
 	}, original)
 }
+
+func TestSemanticallyEqual(t *testing.T) {
+	value := map[string]interface{}{
+		"type": "static",
+		"values": map[string]interface{}{
+			"host": "example.com",
+			"port": 5432,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		data     string
+		expected bool
+	}{
+		{
+			name:     "compact json",
+			data:     `{"type":"static","values":{"host":"example.com","port":5432}}`,
+			expected: true,
+		},
+		{
+			name:     "reordered and indented json",
+			data:     "{\n  \"values\": {\"port\": 5432, \"host\": \"example.com\"},\n  \"type\": \"static\"\n}",
+			expected: true,
+		},
+		{
+			name:     "yaml",
+			data:     "type: static\nvalues:\n  host: example.com\n  port: 5432\n",
+			expected: true,
+		},
+		{
+			name:     "different value",
+			data:     `{"type":"static","values":{"host":"example.com","port":5433}}`,
+			expected: false,
+		},
+		{
+			name:     "invalid",
+			data:     `{"type":`,
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, semanticallyEqual(tc.data, value))
+		})
+	}
+}
+
+func TestNormalizeJSON(t *testing.T) {
+	tests := []struct {
+		data     string
+		expected string
+		err      bool
+	}{
+		{data: `{"b": 1, "a": [1, 2]}`, expected: `{"a":[1,2],"b":1}`},
+		{data: "{\n  \"a\": \"<tag>\"\n}\n", expected: `{"a":"<tag>"}`},
+		{data: `12345678901234567890`, expected: `12345678901234567890`},
+		{data: `{"a": 1`, err: true},
+		{data: `{} {}`, err: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.data, func(t *testing.T) {
+			normalized, err := normalizeJSON(tc.data)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, normalized)
+		})
+	}
+
+	assert.True(t, jsonEqual(`{"a": 1, "b": 2}`, `{"b":2,"a":1}`))
+	assert.False(t, jsonEqual(`{"a": 1}`, `{"a": 2}`))
+}
+
+func TestYAMLEqual(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(yamlEqual("a: 1\nb:\n  - x\n  - y\n", "b: [x, y]\na: 1"))
+	assert.True(yamlEqual("# comment\nname: \"test\"\n", "name: test\n"))
+	assert.False(yamlEqual("a: 1\n", "a: 2\n"))
+	assert.False(yamlEqual("b: [x, y]\n", "b: [y, x]\n"))
+	assert.False(yamlEqual("a: [\n", "a: []\n"))
+}
+
+func TestAttrValueRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	value := map[string]interface{}{
+		"host":    "example.com",
+		"port":    float64(5432),
+		"no_tls":  true,
+		"missing": nil,
+		"labels":  map[string]interface{}{"name": "test"},
+		"zones":   []interface{}{"a", "b"},
+	}
+
+	attrValue, diags := interfaceToAttrValue(value)
+	assert.False(diags.HasError())
+
+	converted, err := attrValueToInterface(types.DynamicValue(attrValue))
+	assert.NoError(err)
+	assert.Equal(value, converted)
+}
+
+func TestAttrValueToInterfaceUnknown(t *testing.T) {
+	_, err := attrValueToInterface(types.DynamicUnknown())
+	assert.Error(t, err)
+}
+
+func TestImportIDParts(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          string
+		collections []string
+		expected    []string
+		expectError bool
+	}{
+		{name: "plain identifier", id: "app/env", collections: []string{"apps", "envs"}, expected: []string{"app", "env"}},
+		{name: "plain identifier with separator", id: "app::env::path/to/KEY", collections: []string{"apps", "envs", "values"}, expected: []string{"app", "env", "path/to/KEY"}},
+		{name: "environment", id: "https://app.humanitec.io/orgs/test-org/apps/app/envs/env", collections: []string{"apps", "envs"}, expected: []string{"app", "env"}},
+		{name: "trailing segments", id: "https://app.humanitec.io/orgs/test-org/apps/app/envs/env/status?tab=resources", collections: []string{"apps", "envs"}, expected: []string{"app", "env"}},
+		{name: "app value", id: "https://app.humanitec.io/orgs/test-org/apps/app/values/KEY", collections: []string{"apps", "envs", "values"}, expected: []string{"app", "KEY"}},
+		{name: "env value", id: "https://app.humanitec.io/orgs/test-org/apps/app/envs/env/values/KEY", collections: []string{"apps", "envs", "values"}, expected: []string{"app", "env", "KEY"}},
+		{name: "value key with slashes", id: "https://app.humanitec.io/orgs/test-org/apps/app/envs/env/values/path%2Fto%2FKEY", collections: []string{"apps", "envs", "values"}, expected: []string{"app", "env", "path/to/KEY"}},
+		{name: "pipeline", id: "https://app.humanitec.io/orgs/test-org/apps/app/pipelines/pipeline/runs", collections: []string{"apps", "pipelines"}, expected: []string{"app", "pipeline"}},
+		{name: "missing organization", id: "https://app.humanitec.io/apps/app/envs/env", collections: []string{"apps", "envs"}, expectError: true},
+		{name: "other organization", id: "https://app.humanitec.io/orgs/other-org/apps/app/envs/env", collections: []string{"apps", "envs"}, expectError: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			idParts, err := importIDParts(tc.id, "test-org", tc.collections...)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, idParts)
+		})
+	}
+}
+
+func TestSplitImportID(t *testing.T) {
+	assert.Equal(t, []string{"app", "env", "key"}, splitImportID("app/env/key"))
+	assert.Equal(t, []string{"app", "path/to/key"}, splitImportID("app::path/to/key"))
+	assert.Equal(t, []string{"app", "env", "path/to/key"}, splitImportID("app::env::path/to/key"))
+}
+
+func TestWithTimeout(t *testing.T) {
+	ctx := context.Background()
+	attrTypes := timeouts.Attributes(ctx, timeouts.Opts{Create: true, Read: true}).GetType().(timeouts.Type).AttrTypes
+	configured := timeouts.Value{Object: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"create": types.StringValue("1m"),
+		"read":   types.StringNull(),
+	})}
+
+	tests := []struct {
+		name     string
+		timeout  func(context.Context, time.Duration) (time.Duration, diag.Diagnostics)
+		expected time.Duration
+	}{
+		{name: "configured", timeout: configured.Create, expected: time.Minute},
+		{name: "default", timeout: configured.Read, expected: defaultTimeout},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			timeoutCtx, cancel := withTimeout(ctx, tc.timeout, &diags)
+			defer cancel()
+
+			assert.False(t, diags.HasError(), diags)
+			deadline, ok := timeoutCtx.Deadline()
+			assert.True(t, ok)
+			assert.WithinDuration(t, time.Now().Add(tc.expected), deadline, time.Second)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		invalid := timeouts.Value{Object: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"create": types.StringValue("soon"),
+			"read":   types.StringNull(),
+		})}
+
+		var diags diag.Diagnostics
+		timeoutCtx, cancel := withTimeout(ctx, invalid.Create, &diags)
+		defer cancel()
+
+		assert.True(t, diags.HasError())
+		_, ok := timeoutCtx.Deadline()
+		assert.False(t, ok)
+	})
+}
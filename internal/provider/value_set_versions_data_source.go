@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/hashcode"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ValueSetVersionsDataSource{}
+
+func NewValueSetVersionsDataSource() datasource.DataSource {
+	return &ValueSetVersionsDataSource{}
+}
+
+// ValueSetVersionsDataSource defines the data source implementation.
+type ValueSetVersionsDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// ValueSetVersionsDataSourceModel describes the data source data model.
+type ValueSetVersionsDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	AppID            types.String `tfsdk:"app_id"`
+	EnvID            types.String `tfsdk:"env_id"`
+	KeyChanged       types.String `tfsdk:"key_changed"`
+	ValueSetVersions types.List   `tfsdk:"value_set_versions"`
+}
+
+// ValueSetVersionModel describes a single value set version.
+type ValueSetVersionModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Comment                 types.String `tfsdk:"comment"`
+	CreatedAt               types.String `tfsdk:"created_at"`
+	CreatedBy               types.String `tfsdk:"created_by"`
+	ResultOf                types.String `tfsdk:"result_of"`
+	SourceValueSetVersionID types.String `tfsdk:"source_value_set_version_id"`
+}
+
+var valueSetVersionAttrTypes = map[string]attr.Type{
+	"id":                          types.StringType,
+	"comment":                     types.StringType,
+	"created_at":                  types.StringType,
+	"created_by":                  types.StringType,
+	"result_of":                   types.StringType,
+	"source_value_set_version_id": types.StringType,
+}
+
+func (d *ValueSetVersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_value_set_versions"
+}
+
+func (d *ValueSetVersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Value Set Versions are the track record of Shared Values changes of an Application or Environment. They can be used to restore a previous version with `humanitec_value_set_version_restore`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Application.",
+				Required:            true,
+			},
+			"env_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Environment. If not set, the Application level Value Set Versions are returned.",
+				Optional:            true,
+			},
+			"key_changed": schema.StringAttribute{
+				MarkdownDescription: "Only return Value Set Versions where the Shared Value with this key changed.",
+				Optional:            true,
+			},
+			"value_set_versions": schema.ListAttribute{
+				MarkdownDescription: "List of Value Set Versions, newest first.",
+				ElementType: types.ObjectType{
+					AttrTypes: valueSetVersionAttrTypes,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *ValueSetVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *ValueSetVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ValueSetVersionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := data.AppID.ValueString()
+	keyChanged := data.KeyChanged.ValueStringPointer()
+
+	var res *[]client.ValueSetVersionResponse
+	if data.EnvID.IsNull() {
+		httpResp, err := d.client.GetOrgsOrgIdAppsAppIdValueSetVersionsWithResponse(ctx, d.orgId, appID, &client.GetOrgsOrgIdAppsAppIdValueSetVersionsParams{
+			KeyChanged: keyChanged,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list value set versions, got error: %s", err))
+			return
+		}
+		if httpResp.StatusCode() != 200 {
+			resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list value set versions, unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body))
+			return
+		}
+		res = httpResp.JSON200
+	} else {
+		httpResp, err := d.client.GetOrgsOrgIdAppsAppIdEnvsEnvIdValueSetVersionsWithResponse(ctx, d.orgId, appID, data.EnvID.ValueString(), &client.GetOrgsOrgIdAppsAppIdEnvsEnvIdValueSetVersionsParams{
+			KeyChanged: keyChanged,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list value set versions, got error: %s", err))
+			return
+		}
+		if httpResp.StatusCode() != 200 {
+			resp.Diagnostics.AddError(HUM_API_ERR, fmt.Sprintf("Unable to list value set versions, unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body))
+			return
+		}
+		res = httpResp.JSON200
+	}
+
+	versionIds := []string{}
+	versions := []basetypes.ObjectValue{}
+	if res != nil {
+		for _, vsv := range *res {
+			version, diags := types.ObjectValueFrom(ctx, valueSetVersionAttrTypes, parseValueSetVersionResponse(vsv))
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			versionIds = append(versionIds, vsv.Id)
+			versions = append(versions, version)
+		}
+	}
+
+	versionsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: valueSetVersionAttrTypes}, versions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ValueSetVersions = versionsList
+	data.ID = types.StringValue(hashcode.Strings(versionIds))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func parseValueSetVersionResponse(res client.ValueSetVersionResponse) *ValueSetVersionModel {
+	resultOf := types.StringNull()
+	if res.ResultOf != nil {
+		resultOf = types.StringValue(string(*res.ResultOf))
+	}
+
+	return &ValueSetVersionModel{
+		ID:                      types.StringValue(res.Id),
+		Comment:                 types.StringValue(res.Comment),
+		CreatedAt:               types.StringValue(res.CreatedAt.Format(time.RFC3339)),
+		CreatedBy:               types.StringValue(res.CreatedBy),
+		ResultOf:                resultOf,
+		SourceValueSetVersionID: types.StringPointerValue(res.SourceValueSetVersionId),
+	}
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccValueSetVersionsDataSource(t *testing.T) {
+	appID := fmt.Sprintf("val-set-test-app-%d", time.Now().UnixNano())
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a value with two versions
+			{
+				Config: testAccValueSetVersionsDataSourceConfig(appID, "v1", false),
+			},
+			{
+				Config: testAccValueSetVersionsDataSourceConfig(appID, "v2", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.humanitec_value_set_versions.test", "value_set_versions.#", "2"),
+					resource.TestCheckResourceAttr("data.humanitec_value_set_versions.test", "value_set_versions.0.result_of", "app_value_update"),
+				),
+			},
+			// Restore the initial version
+			{
+				Config: testAccValueSetVersionsDataSourceConfig(appID, "v2", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("humanitec_value_set_version_restore.test", "id"),
+				),
+			},
+			// The restored value is refreshed without replacing the restore
+			{
+				Config: testAccValueSetVersionsDataSourceConfig(appID, "v2", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("humanitec_value.test", "value", "v1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccValueSetVersionsDataSourceConfig(appID, value string, restore bool) string {
+	restoreConfig := ""
+	lifecycleConfig := ""
+	if restore {
+		// The version creating the value stays the same while later versions are added
+		restoreConfig = `
+resource "humanitec_value_set_version_restore" "test" {
+	app_id               = humanitec_application.test.id
+	value_set_version_id = one([for v in data.humanitec_value_set_versions.test.value_set_versions : v.id if v.result_of == "app_value_create"])
+	comment              = "Restore initial value"
+}
+`
+		// The restore changes the value outside of its resource
+		lifecycleConfig = `
+	lifecycle {
+		ignore_changes = [value]
+	}
+`
+	}
+
+	return fmt.Sprintf(`
+resource "humanitec_application" "test" {
+	id   = "%s"
+	name = "%s"
+}
+
+resource "humanitec_value" "test" {
+	app_id      = humanitec_application.test.id
+	key         = "VAL_SET_TEST"
+	value       = "%s"
+	description = "value set version test"
+	is_secret   = false
+%s}
+
+data "humanitec_value_set_versions" "test" {
+	app_id      = humanitec_application.test.id
+	key_changed = humanitec_value.test.key
+}
+%s
+`, appID, appID, value, lifecycleConfig, restoreConfig)
+}
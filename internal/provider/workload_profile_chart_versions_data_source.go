@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/humanitec/humanitec-go-autogen"
+	"github.com/humanitec/humanitec-go-autogen/client"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/hashcode"
+	"github.com/humanitec/terraform-provider-humanitec/internal/pagination"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WorkloadProfileChartVersionsDataSource{}
+
+func NewWorkloadProfileChartVersionsDataSource() datasource.DataSource {
+	return &WorkloadProfileChartVersionsDataSource{}
+}
+
+// WorkloadProfileChartVersionsDataSource defines the data source implementation.
+type WorkloadProfileChartVersionsDataSource struct {
+	client *humanitec.Client
+	orgId  string
+}
+
+// WorkloadProfileChartVersionsDataSourceModel describes the data source data model.
+type WorkloadProfileChartVersionsDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ChartID       types.String `tfsdk:"chart_id"`
+	ChartVersions types.List   `tfsdk:"chart_versions"`
+}
+
+// WorkloadProfileChartVersionDataSourceModel describes a single workload profile chart version.
+type WorkloadProfileChartVersionDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Version   types.String `tfsdk:"version"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	CreatedBy types.String `tfsdk:"created_by"`
+}
+
+var workloadProfileChartVersionAttrTypes = map[string]attr.Type{
+	"id":         types.StringType,
+	"version":    types.StringType,
+	"created_at": types.StringType,
+	"created_by": types.StringType,
+}
+
+func (d *WorkloadProfileChartVersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workload_profile_chart_versions"
+}
+
+func (d *WorkloadProfileChartVersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Workload Profile Chart Versions uploaded to the organization. Can be used to pin a `humanitec_workload_profile` to the latest version of a chart.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"chart_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Workload Profile Chart.",
+				Required:            true,
+			},
+			"chart_versions": schema.ListAttribute{
+				MarkdownDescription: "List of versions of the chart, newest first.",
+				ElementType: types.ObjectType{
+					AttrTypes: workloadProfileChartVersionAttrTypes,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *WorkloadProfileChartVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resdata, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", err.Error())
+		return
+	}
+
+	// Prevent panic if the provider has not been configured.
+	if resdata == nil {
+		return
+	}
+
+	d.client = resdata.Client
+	d.orgId = resdata.OrgID
+}
+
+func (d *WorkloadProfileChartVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkloadProfileChartVersionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allChartVersions, err := pagination.All(ctx, func(ctx context.Context, editor pagination.RequestEditor) ([]client.WorkloadProfileChartVersionResponse, *http.Response, error) {
+		httpResp, err := d.client.ListWorkloadProfileChartVersionsWithResponse(ctx, d.orgId, &client.ListWorkloadProfileChartVersionsParams{
+			Id: data.ChartID.ValueStringPointer(),
+		}, editor)
+		if err != nil {
+			return nil, nil, err
+		}
+		if httpResp.StatusCode() != 200 {
+			return nil, nil, fmt.Errorf("unexpected status code: %d, body: %s", httpResp.StatusCode(), httpResp.Body)
+		}
+		if httpResp.JSON200 == nil {
+			return nil, httpResp.HTTPResponse, nil
+		}
+		return *httpResp.JSON200, httpResp.HTTPResponse, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(HUM_CLIENT_ERR, fmt.Sprintf("Unable to list workload profile chart versions, got error: %s", err))
+		return
+	}
+
+	chartVersions := []client.WorkloadProfileChartVersionResponse{}
+	for _, v := range allChartVersions {
+		if v.Id == data.ChartID.ValueString() {
+			chartVersions = append(chartVersions, v)
+		}
+	}
+	sortWorkloadProfileChartVersions(chartVersions)
+
+	versionIds := []string{}
+	versions := []basetypes.ObjectValue{}
+	for _, v := range chartVersions {
+		version, diags := types.ObjectValueFrom(ctx, workloadProfileChartVersionAttrTypes, &WorkloadProfileChartVersionDataSourceModel{
+			ID:        types.StringValue(v.Id),
+			Version:   types.StringValue(v.Version),
+			CreatedAt: types.StringValue(v.CreatedAt.Format(time.RFC3339)),
+			CreatedBy: types.StringValue(v.CreatedBy),
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		versionIds = append(versionIds, fmt.Sprintf("%s/%s", v.Id, v.Version))
+		versions = append(versions, version)
+	}
+
+	versionsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: workloadProfileChartVersionAttrTypes}, versions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ChartVersions = versionsList
+	data.ID = types.StringValue(hashcode.Strings(versionIds))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// sortWorkloadProfileChartVersions sorts the chart versions by creation date, newest first.
+func sortWorkloadProfileChartVersions(versions []client.WorkloadProfileChartVersionResponse) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].CreatedAt.After(versions[j].CreatedAt)
+	})
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/humanitec/humanitec-go-autogen/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccWorkloadProfileChartVersionsDataSource(t *testing.T) {
+	chartVersionID := fmt.Sprintf("versions-%d", time.Now().UnixNano())
+
+	dir, err := os.MkdirTemp("", "tph-chart-test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Create a minimal helm chart
+	assert.NoError(t, os.WriteFile(fmt.Sprintf("%s/Chart.yaml", dir), []byte(fmt.Sprintf(`
+apiVersion: v2
+name: %s
+version: 1.0.0
+`, chartVersionID)), 0644))
+
+	f, err := os.CreateTemp("", "tph-chart-test.tar.gz")
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	defer os.Remove(f.Name())
+
+	assert.NoError(t, compressDirectory(dir, f.Name()))
+
+	testAccTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadProfileChartVersionsDataSourceConfig(f.Name()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.humanitec_workload_profile_chart_versions.test", "chart_versions.#", "1"),
+					resource.TestCheckResourceAttr("data.humanitec_workload_profile_chart_versions.test", "chart_versions.0.id", chartVersionID),
+					resource.TestCheckResourceAttr("data.humanitec_workload_profile_chart_versions.test", "chart_versions.0.version", "1.0.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkloadProfileChartVersionsDataSourceConfig(file string) string {
+	return fmt.Sprintf(`
+resource "humanitec_workload_profile_chart_version" "test" {
+	filename = "%s"
+	source_code_hash = filebase64sha256("%s")
+}
+
+data "humanitec_workload_profile_chart_versions" "test" {
+	chart_id = humanitec_workload_profile_chart_version.test.id
+}
+`, file, file)
+}
+
+func TestSortWorkloadProfileChartVersions(t *testing.T) {
+	now := time.Now()
+	versions := []client.WorkloadProfileChartVersionResponse{
+		{Id: "chart", Version: "1.0.0", CreatedAt: now.Add(-2 * time.Hour)},
+		{Id: "chart", Version: "1.2.0", CreatedAt: now},
+		{Id: "chart", Version: "1.1.0", CreatedAt: now.Add(-1 * time.Hour)},
+	}
+
+	sortWorkloadProfileChartVersions(versions)
+
+	assert.Equal(t, []string{"1.2.0", "1.1.0", "1.0.0"}, []string{versions[0].Version, versions[1].Version, versions[2].Version})
+}
@@ -1,11 +1,12 @@
 package main
 
 import (
-	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"github.com/humanitec/terraform-provider-humanitec/internal/provider"
 )
 
@@ -24,20 +25,55 @@ var (
 	version string = "dev"
 )
 
+const providerAddress = "registry.terraform.io/humanitec/humanitec"
+
+// logFileEnv names the environment variable pointing to a file the provider logs are appended to in debug mode.
+const logFileEnv = "HUMANITEC_PROVIDER_LOG_FILE"
+
 func main() {
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		Address: "registry.terraform.io/humanitec/humanitec",
-		Debug:   debug,
+	var opts []tf6server.ServeOpt
+	if debug {
+		opts = append(opts, tf6server.WithManagedDebug())
+
+		// Outside of debug mode Terraform collects the provider logs, see TF_LOG_PATH
+		if path := os.Getenv(logFileEnv); path != "" {
+			restore, err := redirectLogs(path)
+			if err != nil {
+				log.Fatal(err.Error())
+			}
+			defer restore()
+
+			opts = append(opts, tf6server.WithoutLogStderrOverride())
+		}
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	err := tf6server.Serve(providerAddress, providerserver.NewProtocol6(provider.New(version)()), opts...)
 
 	if err != nil {
 		log.Fatal(err.Error())
 	}
 }
+
+// redirectLogs appends everything written to the standard error of the process, which includes the provider logs
+// when served with tf6server.WithoutLogStderrOverride, to the file at path. The returned func restores the output.
+func redirectLogs(path string) (func(), error) {
+	logFile, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	stderr := os.Stderr
+	os.Stderr = logFile
+	log.SetOutput(logFile)
+
+	return func() {
+		os.Stderr = stderr
+		log.SetOutput(stderr)
+		logFile.Close()
+	}, nil
+}
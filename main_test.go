@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/humanitec/terraform-provider-humanitec/internal/provider"
+)
+
+func TestDebugLogFile(t *testing.T) {
+	t.Setenv("TF_LOG", "TRACE")
+	path := filepath.Join(t.TempDir(), "provider.log")
+
+	restore, err := redirectLogs(path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer restore()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reattachCh := make(chan *plugin.ReattachConfig)
+	closeCh := make(chan struct{})
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- tf6server.Serve(providerAddress, providerserver.NewProtocol6(provider.New("test")()),
+			tf6server.WithDebug(ctx, reattachCh, closeCh), tf6server.WithoutLogStderrOverride())
+	}()
+
+	var reattach *plugin.ReattachConfig
+	select {
+	case reattach = <-reattachCh:
+	case err := <-serveErr:
+		t.Fatalf("provider stopped before it was ready: %v", err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting on reattach configuration")
+	}
+
+	conn, err := grpc.NewClient(reattach.Addr.Network()+":"+reattach.Addr.String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+
+	// The response is decoded into an empty message, only the logs of the call are of interest
+	assert.NoError(t, conn.Invoke(ctx, "/tfplugin6.Provider/GetMetadata", &emptypb.Empty{}, &emptypb.Empty{}))
+
+	cancel()
+	select {
+	case <-closeCh:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting on the provider to stop")
+	}
+	assert.NoError(t, <-serveErr)
+
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "GetMetadata")
+}